@@ -0,0 +1,165 @@
+package CPT
+
+import (
+	"math"
+
+	pkg "github.com/geoport/GeoGo/internal"
+	"github.com/geoport/GeoGo/models"
+)
+
+// calcEquivalentConeResistance returns the equivalent cone resistance of the LCPC method. The cone resistances
+// between Df and Df + 1.5B are clipped at 1.3 times their average before averaging.
+func calcEquivalentConeResistance(cptData models.CPT, Df, B float64) float64 {
+	_, qcs, thicknesses := getSegments(cptData, Df, Df+1.5*B)
+	qcm := weightedMean(qcs, thicknesses)
+
+	clipped := make([]float64, len(qcs))
+	for i, qc := range qcs {
+		clipped[i] = math.Min(qc, 1.3*qcm)
+	}
+	return weightedMean(clipped, thicknesses)
+}
+
+// calcEquivalentDepth returns the equivalent embedment depth De = (1/qce)·∫qc·dz between surface and Df.
+func calcEquivalentDepth(cptData models.CPT, Df, qce float64) float64 {
+	if qce == 0 {
+		return 0
+	}
+	_, qcs, thicknesses := getSegments(cptData, 0, Df)
+
+	var sum float64
+	for i, qc := range qcs {
+		sum += qc * thicknesses[i]
+	}
+	return sum / qce
+}
+
+// calcKc returns the bearing factor kc of the LCPC method (Fascicule 62, Titre V).
+//
+// Parameters:
+//
+// - isCohesive (bool): Whether the foundation layer is clay or silt.
+//
+// - qce (float64): Equivalent cone resistance (in t/m2).
+//
+// - De (float64): Equivalent embedment depth (in meters).
+//
+// - B (float64): Width of the foundation (in meters).
+//
+// - L (float64): Length of the foundation (in meters).
+//
+// Returns:
+//
+// - kc (float64)
+func calcKc(isCohesive bool, qce, De, B, L float64) float64 {
+	var k0, a float64
+	if isCohesive {
+		k0, a = 0.32, 0.35
+	} else if qce < 5*pkg.MPa {
+		k0, a = 0.14, 0.35
+	} else if qce < 12*pkg.MPa {
+		k0, a = 0.11, 0.5
+	} else {
+		k0, a = 0.08, 0.85
+	}
+	return k0 * (1 + a*(0.6+0.4*B/L)*De/B)
+}
+
+// calcAverageEffectiveConeResistance returns the geometric average of the effective cone resistance qE = qc - u
+// between Df and Df + 1.5B as proposed by Eslami & Fellenius.
+func calcAverageEffectiveConeResistance(cptData models.CPT, Df, B float64) float64 {
+	exps, thicknesses := cptData.GetSegments(Df, Df+1.5*B)
+	qEs := make([]float64, len(exps))
+	for i, exp := range exps {
+		qEs[i] = exp.ConeResistance - exp.PorePressure
+	}
+	return geometricMean(qEs, thicknesses)
+}
+
+// calcEslamiCt returns the correlation coefficient of Eslami & Fellenius, Ct = 1/(3B) with B in meters, which is
+// limited to 1 for narrow foundations.
+func calcEslamiCt(B float64) float64 {
+	return math.Min(1, 1/(3*B))
+}
+
+// calcAverageConeResistance returns the average cone resistance between Df + B/2 and Df + 1.1B, the depth range of
+// Schmertmann's (1978) direct correlations.
+func calcAverageConeResistance(cptData models.CPT, Df, B float64) float64 {
+	_, qcs, thicknesses := getSegments(cptData, Df+B/2, Df+1.1*B)
+	return weightedMean(qcs, thicknesses)
+}
+
+// calcSchmertmannCapacity returns the ultimate bearing capacity in t/m2 by Schmertmann's (1978) direct correlations
+// for strip and square foundations, which are valid for Df/B ≤ 1.5.
+func calcSchmertmannCapacity(isCohesive bool, qc float64, foundationType string) float64 {
+	// the correlations are in kg/cm2
+	qc = qc / 10
+
+	var qu float64
+	if isCohesive {
+		if foundationType == "strip" {
+			qu = 2 + 0.28*qc
+		} else {
+			qu = 5 + 0.34*qc
+		}
+	} else {
+		qc = math.Min(qc, 300)
+		if foundationType == "strip" {
+			qu = 28 - 0.0052*math.Pow(300-qc, 1.5)
+		} else {
+			qu = 48 - 0.009*math.Pow(300-qc, 1.5)
+		}
+	}
+	return math.Max(qu, 0) * 10
+}
+
+// CalcBearingCapacity calculates the ultimate bearing capacity of a shallow foundation directly from cone
+// resistance by the LCPC (Bustamante & Gianeselli, Fascicule 62), Eslami & Fellenius (1997) and Schmertmann (1978)
+// methods.
+//
+// Parameters:
+//
+// - soilProfile (models.SoilProfile): The soil profile to be analyzed.
+//
+// - foundationData (models.Foundation): The foundation data to be analyzed.
+//
+// - cptData (models.CPT): CPT experiments. Cone resistance and pore pressure are in t/m2.
+//
+// Returns:
+//
+// - result (Result)
+func CalcBearingCapacity(soilProfile models.SoilProfile, foundationData models.Foundation, cptData models.CPT) Result {
+	Df := foundationData.FoundationDepth
+	B := foundationData.FoundationWidth
+	L := foundationData.FoundationLength
+
+	layer := soilProfile.Layers[soilProfile.GetLayerIndex(Df)]
+	q0 := soilProfile.CalcNormalStress(Df)
+
+	qce := calcEquivalentConeResistance(cptData, Df, B)
+	De := calcEquivalentDepth(cptData, Df, qce)
+	kc := calcKc(layer.IsCohesive, qce, De, B, L)
+
+	qEg := calcAverageEffectiveConeResistance(cptData, Df, B)
+	Ct := calcEslamiCt(B)
+
+	qc := calcAverageConeResistance(cptData, Df, B)
+
+	return Result{
+		LCPC: LCPCResult{
+			EquivalentConeResistance: qce,
+			EquivalentDepth:          De,
+			Kc:                       kc,
+			UltimateBearingCapacity:  kc*qce + q0,
+		},
+		EslamiFellenius: EslamiFelleniusResult{
+			AverageEffectiveConeResistance: qEg,
+			Ct:                             Ct,
+			UltimateBearingCapacity:        Ct * qEg,
+		},
+		Schmertmann: SchmertmannResult{
+			AverageConeResistance:   qc,
+			UltimateBearingCapacity: calcSchmertmannCapacity(layer.IsCohesive, qc, foundationData.FoundationType),
+		},
+	}
+}
@@ -0,0 +1,70 @@
+package CPT
+
+import (
+	"testing"
+
+	dt "github.com/geoport/GeoGo/data"
+	pkg "github.com/geoport/GeoGo/internal"
+	"github.com/geoport/GeoGo/models"
+)
+
+func getTestData() (models.SoilProfile, models.Foundation) {
+	soilProfile := dt.SoilProfile.Copy()
+	soilProfile.CalcLayerDepths()
+	foundationData := dt.FoundationData
+	foundationData.FoundationWidth = 2
+	foundationData.FoundationLength = 2
+	foundationData.FoundationType = "square"
+
+	return soilProfile, foundationData
+}
+
+func TestCalcEquivalentConeResistance(t *testing.T) {
+	expected := 473.33
+	output := calcEquivalentConeResistance(dt.CPT, 2, 2)
+	if !pkg.AssertFloat(output, expected, 0.01) {
+		t.Errorf("Got %v, want %v", output, expected)
+	}
+}
+
+func TestCalcStrainInfluence(t *testing.T) {
+	inputs := []float64{0, 1, 2.5, 4, 5}
+	expected := []float64{0.1, 0.6, 0.3, 0, 0}
+	for i, z := range inputs {
+		output := calcStrainInfluence(z, 2, 0, 0.6)
+		if !pkg.AssertFloat(output, expected[i], 0.001) {
+			t.Errorf("Got %v, want %v for z = %v", output, expected[i], z)
+		}
+	}
+}
+
+func TestCalcBearingCapacity(t *testing.T) {
+	soilProfile, foundationData := getTestData()
+
+	expectedLCPC := 191.33
+	expectedEslami := 78.24
+	expectedSchmertmann := 210.93
+	output := CalcBearingCapacity(soilProfile, foundationData, dt.CPT)
+	if !pkg.AssertFloat(output.LCPC.UltimateBearingCapacity, expectedLCPC, 0.01) {
+		t.Errorf("Got %v, want %v for LCPC", output.LCPC.UltimateBearingCapacity, expectedLCPC)
+	}
+	if !pkg.AssertFloat(output.EslamiFellenius.UltimateBearingCapacity, expectedEslami, 0.01) {
+		t.Errorf("Got %v, want %v for Eslami & Fellenius", output.EslamiFellenius.UltimateBearingCapacity, expectedEslami)
+	}
+	if !pkg.AssertFloat(output.Schmertmann.UltimateBearingCapacity, expectedSchmertmann, 0.01) {
+		t.Errorf("Got %v, want %v for Schmertmann", output.Schmertmann.UltimateBearingCapacity, expectedSchmertmann)
+	}
+}
+
+func TestCalcSettlement(t *testing.T) {
+	soilProfile, foundationData := getTestData()
+
+	expected := 2.4
+	output := CalcSettlement(soilProfile, foundationData, 20, dt.CPT, 10)
+	if !pkg.AssertFloat(output.Settlement, expected, 0.01) {
+		t.Errorf("Got %v, want %v", output.Settlement, expected)
+	}
+	if output.C2 != 1.4 {
+		t.Errorf("Got %v, want %v for C2", output.C2, 1.4)
+	}
+}
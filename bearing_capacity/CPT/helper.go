@@ -0,0 +1,48 @@
+package CPT
+
+import (
+	"math"
+
+	"github.com/geoport/GeoGo/models"
+)
+
+// getSegments returns the depths, cone resistances and tributary thicknesses of the experiments between the given
+// depths.
+func getSegments(cptData models.CPT, top, bottom float64) ([]float64, []float64, []float64) {
+	exps, thicknesses := cptData.GetSegments(top, bottom)
+
+	depths := make([]float64, len(exps))
+	qcs := make([]float64, len(exps))
+	for i, exp := range exps {
+		depths[i] = exp.Depth
+		qcs[i] = exp.ConeResistance
+	}
+	return depths, qcs, thicknesses
+}
+
+// weightedMean returns the thickness weighted average of the values.
+func weightedMean(values, thicknesses []float64) float64 {
+	var sum, total float64
+	for i, v := range values {
+		sum += v * thicknesses[i]
+		total += thicknesses[i]
+	}
+	if total == 0 {
+		return 0
+	}
+	return sum / total
+}
+
+// geometricMean returns the thickness weighted geometric average of the values. Values are limited to a small
+// positive number so that a zero reading does not vanish the average.
+func geometricMean(values, thicknesses []float64) float64 {
+	var sum, total float64
+	for i, v := range values {
+		sum += math.Log(math.Max(v, 1e-3)) * thicknesses[i]
+		total += thicknesses[i]
+	}
+	if total == 0 {
+		return 0
+	}
+	return math.Exp(sum / total)
+}
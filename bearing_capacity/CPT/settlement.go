@@ -0,0 +1,113 @@
+package CPT
+
+import (
+	"math"
+
+	"github.com/geoport/GeoGo/models"
+)
+
+// calcShapeRatio returns the interpolation ratio between the axisymmetric (L/B = 1) and plane strain (L/B ≥ 10)
+// cases of Schmertmann's method.
+func calcShapeRatio(B, L float64) float64 {
+	return math.Min(math.Max((L/B-1)/9, 0), 1)
+}
+
+// calcStrainInfluence returns the strain influence factor at depth z below the foundation base.
+//
+// Parameters:
+//
+// - z (float64): Depth below the foundation base (in meters).
+//
+// - B (float64): Width of the foundation (in meters).
+//
+// - shapeRatio (float64): 0 for square and round foundations, 1 for strip foundations.
+//
+// - Izp (float64): Peak strain influence factor.
+//
+// Returns:
+//
+// - Iz (float64)
+func calcStrainInfluence(z, B, shapeRatio, Izp float64) float64 {
+	Iz0 := 0.1 + 0.1*shapeRatio
+	zp := (0.5 + 0.5*shapeRatio) * B
+	zMax := (2 + 2*shapeRatio) * B
+
+	if z < 0 || z > zMax {
+		return 0
+	}
+	if z <= zp {
+		return Iz0 + (Izp-Iz0)*z/zp
+	}
+	return Izp * (zMax - z) / (zMax - zp)
+}
+
+// CalcSettlement calculates the settlement of a shallow foundation by Schmertmann's (1978) strain influence method
+// using the cone resistance of the experiments within the influence zone (2B for square, 4B for strip foundations).
+//
+// Parameters:
+//
+// - soilProfile (models.SoilProfile): The soil profile to be analyzed.
+//
+// - foundationData (models.Foundation): The foundation data to be analyzed.
+//
+// - foundationPressure (float64): Pressure applied by the foundation (in t/m2).
+//
+// - cptData (models.CPT): CPT experiments. Cone resistance is in t/m2.
+//
+// - time (float64): Time after construction (in years) for the creep correction.
+//
+// Returns:
+//
+// - result (SettlementResult)
+func CalcSettlement(
+	soilProfile models.SoilProfile, foundationData models.Foundation, foundationPressure float64, cptData models.CPT,
+	time float64,
+) SettlementResult {
+	Df := foundationData.FoundationDepth
+	B := foundationData.FoundationWidth
+	L := foundationData.FoundationLength
+
+	shapeRatio := calcShapeRatio(B, L)
+	zp := (0.5 + 0.5*shapeRatio) * B
+	zMax := (2 + 2*shapeRatio) * B
+
+	stress := soilProfile.CalcEffectiveStress(Df)
+	netPressure := math.Max(foundationPressure-stress, 0)
+	Izp := 0.5 + 0.1*math.Sqrt(netPressure/soilProfile.CalcEffectiveStress(Df+zp))
+
+	// embedment correction
+	C1 := 1.
+	if netPressure > 0 {
+		C1 = math.Max(1-0.5*stress/netPressure, 0.5)
+	}
+	// creep correction
+	C2 := 1.
+	if time > 0.1 {
+		C2 = 1 + 0.2*math.Log10(time/0.1)
+	}
+
+	depths, qcs, thicknesses := getSegments(cptData, Df, Df+zMax)
+	Iz := make([]float64, len(depths))
+	Es := make([]float64, len(depths))
+
+	var sum float64
+	for i, depth := range depths {
+		Iz[i] = calcStrainInfluence(depth-Df, B, shapeRatio, Izp)
+		Es[i] = (2.5 + shapeRatio) * qcs[i]
+		sum += Iz[i] / Es[i] * thicknesses[i]
+	}
+
+	settlement := C1 * C2 * netPressure * sum * 100
+
+	return SettlementResult{
+		NetPressure:   netPressure,
+		PeakInfluence: Izp,
+		C1:            C1,
+		C2:            C2,
+		Depths:        depths,
+		Thicknesses:   thicknesses,
+		Iz:            Iz,
+		Es:            Es,
+		Settlement:    settlement,
+	}
+}
@@ -0,0 +1,37 @@
+package CPT
+
+type Result struct {
+	LCPC            LCPCResult            `json:"LCPC"`
+	EslamiFellenius EslamiFelleniusResult `json:"eslamiFellenius"`
+	Schmertmann     SchmertmannResult     `json:"schmertmann"`
+}
+
+type LCPCResult struct {
+	EquivalentConeResistance float64 `json:"equivalentConeResistance"` // t/m2
+	EquivalentDepth          float64 `json:"equivalentDepth"`          // meter
+	Kc                       float64 `json:"kc"`
+	UltimateBearingCapacity  float64 `json:"ultimateBearingCapacity"` // t/m2
+}
+
+type EslamiFelleniusResult struct {
+	AverageEffectiveConeResistance float64 `json:"averageEffectiveConeResistance"` // t/m2, geometric average of qE
+	Ct                             float64 `json:"Ct"`
+	UltimateBearingCapacity        float64 `json:"ultimateBearingCapacity"` // t/m2
+}
+
+type SchmertmannResult struct {
+	AverageConeResistance   float64 `json:"averageConeResistance"`   // t/m2
+	UltimateBearingCapacity float64 `json:"ultimateBearingCapacity"` // t/m2
+}
+
+type SettlementResult struct {
+	NetPressure   float64   `json:"netPressure"` // t/m2
+	PeakInfluence float64   `json:"peakInfluence"`
+	C1            float64   `json:"C1"`
+	C2            float64   `json:"C2"`
+	Depths        []float64 `json:"depths"`
+	Thicknesses   []float64 `json:"thicknesses"`
+	Iz            []float64 `json:"Iz"`
+	Es            []float64 `json:"Es"`         // t/m2
+	Settlement    float64   `json:"settlement"` // cm
+}
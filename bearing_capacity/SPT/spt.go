@@ -0,0 +1,155 @@
+package SPT
+
+import (
+	"math"
+
+	"github.com/geoport/GeoGo/models"
+
+	np "github.com/geoport/numpy4go/vectors"
+)
+
+// allowable settlement in mm that the allowable pressures are calculated for
+const allowableSettlement = 25.
+
+// kPa to t/m2
+const kPa = 9.81
+
+// calcInfluenceDepth returns Burland & Burbidge's depth of influence below the foundation in meters.
+func calcInfluenceDepth(B float64) float64 {
+	return math.Pow(B, 0.763)
+}
+
+// calcAverageN returns the average N60 of the experiments between Df and Df + influence depth. If there is no
+// experiment in that range, the first experiment below the foundation, or the deepest one, is used. Returns 0 if there
+// is no experiment.
+func calcAverageN(sptData models.SPT, Df, influenceDepth float64) float64 {
+	if len(sptData.Exps) == 0 {
+		return 0
+	}
+
+	var values []float64
+	for _, exp := range sptData.Exps {
+		if exp.Depth >= Df && exp.Depth <= Df+influenceDepth {
+			values = append(values, exp.GetN60())
+		}
+	}
+	if len(values) > 0 {
+		return np.Mean(values)
+	}
+
+	for _, exp := range sptData.Exps {
+		if exp.Depth >= Df {
+			return exp.GetN60()
+		}
+	}
+	return sptData.Exps[len(sptData.Exps)-1].GetN60()
+}
+
+// calcMeyerhof returns the allowable net pressure in t/m2 for 25 mm settlement by Meyerhof (1956).
+func calcMeyerhof(N, B float64) float64 {
+	var q float64
+	if B <= 1.22 {
+		q = 11.98 * N
+	} else {
+		q = 7.99 * N * math.Pow((3.28*B+1)/(3.28*B), 2)
+	}
+	return q / kPa
+}
+
+// calcBowles returns the allowable net pressure in t/m2 for 25 mm settlement by Bowles' modification of Meyerhof's
+// method.
+func calcBowles(N, B, Df float64) float64 {
+	Fd := math.Min(1+0.33*Df/B, 1.33)
+
+	var q float64
+	if B <= 1.22 {
+		q = 19.16 * N * Fd
+	} else {
+		q = 11.98 * N * math.Pow((3.28*B+1)/(3.28*B), 2) * Fd
+	}
+	return q / kPa
+}
+
+// calcBurlandBurbidge calculates the settlement of normally consolidated sands by Burland & Burbidge (1985).
+//
+// Parameters:
+//
+// - N (float64): Average N60 over the influence depth.
+//
+// - B (float64): Width of the foundation (in meters).
+//
+// - L (float64): Length of the foundation (in meters).
+//
+// - foundationPressure (float64): Pressure applied by the foundation (in t/m2).
+//
+// Returns:
+//
+// - result (BurlandBurbidge)
+func calcBurlandBurbidge(N, B, L, foundationPressure float64) BurlandBurbidge {
+	Ic := 1.71 / math.Pow(N, 1.4)
+	LB := L / B
+	Fs := math.Pow(1.25*LB/(LB+0.25), 2)
+
+	settlement := Fs * foundationPressure * kPa * math.Pow(B, 0.7) * Ic // mm
+	allowablePressure := allowableSettlement / (Fs * math.Pow(B, 0.7) * Ic) / kPa
+
+	return BurlandBurbidge{
+		Ic:                       Ic,
+		Fs:                       Fs,
+		Settlement:               settlement / 10,
+		AllowableBearingCapacity: allowablePressure,
+	}
+}
+
+// CalcBearingCapacity is a function that returns allowable bearing pressures of sands for 25 mm settlement by
+// Meyerhof, Bowles and Burland & Burbidge methods, and the Burland & Burbidge settlement under the foundation
+// pressure. The allowable bearing capacity of the result is the smallest of the three methods.
+//
+// Parameters:
+//
+// - soilProfile (models.SoilProfile): The soil profile to be analyzed.
+//
+// - foundationData (models.Foundation): The foundation data to be analyzed.
+//
+// - foundationPressure (float64): Pressure applied by the foundation (in t/m2).
+//
+// - sptData (models.SPT): SPT experiments.
+//
+// Returns:
+//
+// - result (Result)
+func CalcBearingCapacity(
+	soilProfile models.SoilProfile, foundationData models.Foundation, foundationPressure float64, sptData models.SPT,
+) Result {
+	Df := foundationData.FoundationDepth
+	B := foundationData.FoundationWidth
+	L := foundationData.FoundationLength
+
+	influenceDepth := calcInfluenceDepth(B)
+	N := calcAverageN(sptData, Df, influenceDepth)
+	if N == 0 {
+		return Result{InfluenceDepth: influenceDepth}
+	}
+
+	// Terzaghi & Peck's correction for very fine or silty sands below the ground water table
+	layer := soilProfile.Layers[soilProfile.GetLayerIndex(Df)]
+	if soilProfile.Gwt <= Df && np.Contains([]string{"SM", "ML"}, layer.SoilClass) && N > 15 {
+		N = 15 + 0.5*(N-15)
+	}
+
+	meyerhof := calcMeyerhof(N, B)
+	bowles := calcBowles(N, B, Df)
+	burlandBurbidge := calcBurlandBurbidge(N, B, L, foundationPressure)
+
+	allowableBearingCapacity := math.Min(math.Min(meyerhof, bowles), burlandBurbidge.AllowableBearingCapacity)
+
+	return Result{
+		AverageN:                 N,
+		InfluenceDepth:           influenceDepth,
+		Meyerhof:                 meyerhof,
+		Bowles:                   bowles,
+		BurlandBurbidge:          burlandBurbidge,
+		AllowableBearingCapacity: allowableBearingCapacity,
+		IsSafe:                   allowableBearingCapacity >= foundationPressure,
+	}
+}
@@ -0,0 +1,60 @@
+package SPT
+
+import (
+	"testing"
+
+	dt "github.com/geoport/GeoGo/data"
+	pkg "github.com/geoport/GeoGo/internal"
+	"github.com/geoport/GeoGo/models"
+)
+
+func TestCalcAverageN(t *testing.T) {
+	expected := 11.5
+	output := calcAverageN(dt.SPT, 2, 3)
+	if !pkg.AssertFloat(output, expected, 0.01) {
+		t.Errorf("Got %v, want %v", output, expected)
+	}
+
+	output = calcAverageN(models.SPT{}, 2, 3)
+	if output != 0 {
+		t.Errorf("Got %v, want %v for no experiments", output, 0)
+	}
+}
+
+func TestCalcBurlandBurbidge(t *testing.T) {
+	expectedSettlement := 1.23
+	expectedAllowable := 40.65
+	output := calcBurlandBurbidge(15, 2, 2, 20)
+	if !pkg.AssertFloat(output.Settlement, expectedSettlement, 0.01) {
+		t.Errorf("Got %v, want %v for settlement", output.Settlement, expectedSettlement)
+	}
+	if !pkg.AssertFloat(output.AllowableBearingCapacity, expectedAllowable, 0.01) {
+		t.Errorf("Got %v, want %v for allowable bearing capacity", output.AllowableBearingCapacity, expectedAllowable)
+	}
+}
+
+func TestCalcBearingCapacity(t *testing.T) {
+	soilProfile := dt.SoilProfile.Copy()
+	soilProfile.CalcLayerDepths()
+	foundationData := dt.FoundationData
+	foundationData.FoundationWidth = 2
+	foundationData.FoundationLength = 2
+
+	output := CalcBearingCapacity(soilProfile, foundationData, 20, dt.SPT)
+
+	expectedN := 10.
+	expectedMeyerhof := 10.82
+	expectedBowles := 21.57
+	if output.AverageN != expectedN {
+		t.Errorf("Got %v, want %v for average N", output.AverageN, expectedN)
+	}
+	if !pkg.AssertFloat(output.Meyerhof, expectedMeyerhof, 0.01) {
+		t.Errorf("Got %v, want %v for Meyerhof", output.Meyerhof, expectedMeyerhof)
+	}
+	if !pkg.AssertFloat(output.Bowles, expectedBowles, 0.01) {
+		t.Errorf("Got %v, want %v for Bowles", output.Bowles, expectedBowles)
+	}
+	if output.IsSafe {
+		t.Errorf("Expected the foundation to be unsafe")
+	}
+}
@@ -0,0 +1,18 @@
+package SPT
+
+type Result struct {
+	AverageN                 float64         `json:"averageN"`
+	InfluenceDepth           float64         `json:"influenceDepth"` // meter
+	Meyerhof                 float64         `json:"meyerhof"`       // t/m2
+	Bowles                   float64         `json:"bowles"`         // t/m2
+	BurlandBurbidge          BurlandBurbidge `json:"burlandBurbidge"`
+	AllowableBearingCapacity float64         `json:"allowableBearingCapacity"`
+	IsSafe                   bool            `json:"isSafe"`
+}
+
+type BurlandBurbidge struct {
+	Ic                       float64 `json:"Ic"`
+	Fs                       float64 `json:"Fs"`
+	Settlement               float64 `json:"settlement"`               // cm
+	AllowableBearingCapacity float64 `json:"allowableBearingCapacity"` // t/m2
+}
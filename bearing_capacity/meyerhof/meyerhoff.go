@@ -66,6 +66,7 @@ func CalcBearingCapacity(
 
 	result := Result{
 		UltimateBearingCapacity: ultimateBearingCapacity,
+		CapacityTerms:           CapacityTerms{C: partC, Q: partQ, G: partG},
 		EffectiveWidth:          B_,
 		EffectiveLength:         L_,
 		BearingCapacityFactors: BearingCapacityFactors{
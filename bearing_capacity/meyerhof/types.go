@@ -8,6 +8,7 @@ type Result struct {
 	SoilParams              BCSoilParams           `json:"soilParams"`
 	EffectiveWidth          float64                `json:"effectiveWidth"`
 	EffectiveLength         float64                `json:"effectiveLength"`
+	CapacityTerms           CapacityTerms          `json:"capacityTerms"`
 	UltimateBearingCapacity float64                `json:"ultimateBearingCapacity"`
 }
 
@@ -40,3 +41,10 @@ type LoadInclinationFactors struct {
 	Ic float64 `json:"Ic"`
 	Ig float64 `json:"Ig"`
 }
+
+// CapacityTerms holds the cohesion (C), surcharge (Q) and unit weight (G) terms of the ultimate bearing capacity.
+type CapacityTerms struct {
+	C float64 `json:"C"`
+	Q float64 `json:"Q"`
+	G float64 `json:"G"`
+}
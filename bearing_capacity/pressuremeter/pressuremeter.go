@@ -8,12 +8,107 @@ import (
 	np "github.com/geoport/numpy4go/vectors"
 )
 
-func calcEffectivePressure(psData models.Pressuremeter) (float64, float64) {
+// getInfluenceZone returns the top and bottom depths of the zone that is used to calculate the equivalent limit
+// pressure (Df - B/2 to Df + 1.5B).
+func getInfluenceZone(Df, B float64) (float64, float64) {
+	return math.Max(Df-B/2, 0), Df + 1.5*B
+}
+
+// interpolateExp returns an experiment at the given depth interpolated from the closest experiments above and below
+// the depth. The second return value is false if the depth is not between two experiments.
+func interpolateExp(psData models.Pressuremeter, depth float64) (InfluenceExp, bool) {
+	var upper, lower *models.PressuremeterExp
+	for i, exp := range psData.Exps {
+		if exp.Depth <= depth && (upper == nil || exp.Depth > upper.Depth) {
+			upper = &psData.Exps[i]
+		}
+		if exp.Depth >= depth && (lower == nil || exp.Depth < lower.Depth) {
+			lower = &psData.Exps[i]
+		}
+	}
+	if upper == nil || lower == nil {
+		return InfluenceExp{}, false
+	}
+
+	ratio := 0.
+	if lower.Depth > upper.Depth {
+		ratio = (depth - upper.Depth) / (lower.Depth - upper.Depth)
+	}
+	return InfluenceExp{
+		Depth:            depth,
+		LimitPressure:    upper.LimitPressure + ratio*(lower.LimitPressure-upper.LimitPressure),
+		NetLimitPressure: upper.NetLimitPressure + ratio*(lower.NetLimitPressure-upper.NetLimitPressure),
+		IsInterpolated:   true,
+	}, true
+}
+
+// getInfluenceExps returns the experiments within the influence zone. When the experiments do not reach a boundary
+// of the zone but there are experiments beyond it, the values at the boundary are interpolated. If no experiment
+// lies within the zone, the experiment closest to the foundation depth is used. Returns nil if there is no experiment.
+func getInfluenceExps(psData models.Pressuremeter, Df, B float64) []InfluenceExp {
+	if len(psData.Exps) == 0 {
+		return nil
+	}
+	top, bottom := getInfluenceZone(Df, B)
+
+	var exps []InfluenceExp
+	var hasAbove, hasBelow, hasTop, hasBottom bool
+	for _, exp := range psData.Exps {
+		if exp.Depth < top {
+			hasAbove = true
+		} else if exp.Depth > bottom {
+			hasBelow = true
+		} else {
+			hasTop = hasTop || exp.Depth == top
+			hasBottom = hasBottom || exp.Depth == bottom
+			exps = append(exps, InfluenceExp{
+				Depth:            exp.Depth,
+				LimitPressure:    exp.LimitPressure,
+				NetLimitPressure: exp.NetLimitPressure,
+			})
+		}
+	}
+
+	if hasAbove && !hasTop {
+		if exp, ok := interpolateExp(psData, top); ok {
+			exps = append([]InfluenceExp{exp}, exps...)
+		}
+	}
+	if hasBelow && !hasBottom {
+		if exp, ok := interpolateExp(psData, bottom); ok {
+			exps = append(exps, exp)
+		}
+	}
+
+	if len(exps) == 0 {
+		closest := psData.Exps[0]
+		for _, exp := range psData.Exps {
+			if math.Abs(exp.Depth-Df) < math.Abs(closest.Depth-Df) {
+				closest = exp
+			}
+		}
+		exps = append(exps, InfluenceExp{
+			Depth:            closest.Depth,
+			LimitPressure:    closest.LimitPressure,
+			NetLimitPressure: closest.NetLimitPressure,
+		})
+	}
+	return exps
+}
+
+// calcEffectivePressure returns the equivalent limit pressure and net limit pressure as the geometric mean of the
+// experiments within the influence zone, together with the experiments that are used. The pressures are 0 if there is
+// no experiment.
+func calcEffectivePressure(psData models.Pressuremeter, Df, B float64) (float64, float64, []InfluenceExp) {
 	effectivePressure := 1.
 	netEffectivePressure := 1.
 
-	numOfData := float64(len(psData.Exps))
-	for _, exp := range psData.Exps {
+	exps := getInfluenceExps(psData, Df, B)
+	if len(exps) == 0 {
+		return 0, 0, exps
+	}
+	numOfData := float64(len(exps))
+	for _, exp := range exps {
 		effectivePressure *= exp.LimitPressure
 		netEffectivePressure *= exp.NetLimitPressure
 	}
@@ -21,7 +116,7 @@ func calcEffectivePressure(psData models.Pressuremeter) (float64, float64) {
 	effectivePressure = math.Pow(effectivePressure, 1/numOfData)
 	netEffectivePressure = math.Pow(netEffectivePressure, 1/numOfData)
 
-	return effectivePressure, netEffectivePressure
+	return effectivePressure, netEffectivePressure, exps
 
 }
 
@@ -64,14 +159,21 @@ func getKp(foundationData models.Foundation, soilProfile models.SoilProfile, eff
 func CalcBearingCapacity(
 	soilProfile models.SoilProfile, foundationData models.Foundation, foundationPressure float64, psData models.Pressuremeter,
 ) Result {
-	effectivePressure, netEffectivePressure := calcEffectivePressure(psData)
+	Df := foundationData.FoundationDepth
+	B := foundationData.FoundationWidth
+
+	effectivePressure, netEffectivePressure, exps := calcEffectivePressure(psData, Df, B)
 	kp := getKp(foundationData, soilProfile, effectivePressure)
+	top, bottom := getInfluenceZone(Df, B)
 
 	quNet := kp * netEffectivePressure
 
 	result := Result{
 		EffectivePressure:        effectivePressure,
 		NetEffectivePressure:     netEffectivePressure,
+		InfluenceZoneTop:         top,
+		InfluenceZoneBottom:      bottom,
+		InfluenceExps:            exps,
 		AllowableBearingCapacity: quNet,
 		IsSafe:                   quNet >= foundationPressure,
 	}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	dt "github.com/geoport/GeoGo/data"
+	"github.com/geoport/GeoGo/models"
 
 	np "github.com/geoport/numpy4go/vectors"
 )
@@ -11,7 +12,7 @@ import (
 func TestCalcEffectivePressure(t *testing.T) {
 	expectedEffectivePressure := 47.85
 	expectedNetEffectivePressure := 42.90
-	outputEffectivePressure, outputNetEffectivePressure := calcEffectivePressure(dt.Pressuremeter)
+	outputEffectivePressure, outputNetEffectivePressure, _ := calcEffectivePressure(dt.Pressuremeter, 2, 10)
 	outputEffectivePressure = np.Round(outputEffectivePressure, 2).(float64)
 	outputNetEffectivePressure = np.Round(outputNetEffectivePressure, 2).(float64)
 	if outputEffectivePressure != expectedEffectivePressure {
@@ -47,3 +48,138 @@ func TestCalcBearingCapacity(t *testing.T) {
 		t.Errorf("Expected QuNet: %f, Actual QuNet: %f", expectedQuNet, outputQuNet)
 	}
 }
+
+func TestGetAlpha(t *testing.T) {
+	soilClasses := []string{"CL", "ML", "SP", "GW"}
+	ratios := []float64{10, 10, 13, 5}
+	expected := []float64{2. / 3, 0.5, 0.5, 0.25}
+
+	for i, soilClass := range soilClasses {
+		output := getAlpha(getSoilType(soilClass), ratios[i])
+		if output != expected[i] {
+			t.Errorf("Expected alpha: %f, Actual alpha: %f for %s", expected[i], output, soilClass)
+		}
+	}
+}
+
+func TestCalcSettlement(t *testing.T) {
+	testSoilData := dt.SoilProfile.Copy()
+	testSoilData.CalcLayerDepths()
+	testFoundationData := dt.FoundationData
+	testFoundationData.FoundationWidth = 2
+	testFoundationData.FoundationLength = 2
+	testPMData := models.Pressuremeter{
+		Exps: []models.PressuremeterExp{
+			{Depth: 2.5, LimitPressure: 45, EM: 480},
+			{Depth: 4.5, LimitPressure: 60, EM: 650},
+			{Depth: 6.5, LimitPressure: 75, EM: 900},
+			{Depth: 8.5, LimitPressure: 90, EM: 1100},
+			{Depth: 10.5, LimitPressure: 110, EM: 1400},
+		},
+	}
+
+	expectedSettlement := 1.41
+	output := CalcSettlement(testSoilData, testFoundationData, 20, testPMData)
+	outputSettlement := np.Round(output.Settlement, 2)
+	if outputSettlement != expectedSettlement {
+		t.Errorf("Expected settlement: %f, Actual settlement: %f", expectedSettlement, outputSettlement)
+	}
+
+	// no experiments or experiments without pressuremeter modulus give a zero result
+	output = CalcSettlement(testSoilData, testFoundationData, 20, models.Pressuremeter{})
+	if output.Settlement != 0 || output.Moduli != nil {
+		t.Errorf("Expected zero settlement without experiments, Actual settlement: %f", output.Settlement)
+	}
+	for i := range testPMData.Exps {
+		testPMData.Exps[i].EM = 0
+	}
+	output = CalcSettlement(testSoilData, testFoundationData, 20, testPMData)
+	if output.Settlement != 0 || output.Moduli != nil {
+		t.Errorf("Expected zero settlement without EM, Actual settlement: %f", output.Settlement)
+	}
+}
+
+func TestProcessTest(t *testing.T) {
+	testSoilData := dt.SoilProfile.Copy()
+	testSoilData.CalcLayerDepths()
+
+	output := ProcessTest(dt.PressuremeterRawTest, testSoilData)
+	exp := output.Exp
+
+	if output.PseudoElasticStart != 2 || output.PseudoElasticEnd != 6 {
+		t.Errorf(
+			"Expected pseudo-elastic range: 2-6, Actual pseudo-elastic range: %d-%d",
+			output.PseudoElasticStart, output.PseudoElasticEnd,
+		)
+	}
+	if np.Round(exp.EM, 2) != 601.72 {
+		t.Errorf("Expected EM: %f, Actual EM: %f", 601.72, exp.EM)
+	}
+	if np.Round(exp.CreepPressure, 2) != 39. {
+		t.Errorf("Expected creep pressure: %f, Actual creep pressure: %f", 39., exp.CreepPressure)
+	}
+	if np.Round(exp.LimitPressure, 2) != 62.33 {
+		t.Errorf("Expected limit pressure: %f, Actual limit pressure: %f", 62.33, exp.LimitPressure)
+	}
+
+	// tests with too few readings give a zero result instead of panicking
+	rawTest := dt.PressuremeterRawTest
+	for _, readings := range [][]models.PressuremeterReading{nil, rawTest.Readings[:1], rawTest.Readings[:2]} {
+		rawTest.Readings = readings
+		output = ProcessTest(rawTest, testSoilData)
+		if output.Exp != (models.PressuremeterExp{}) || output.CorrectedReadings != nil {
+			t.Errorf("Expected zero result for %d readings, Actual result: %v", len(readings), output)
+		}
+	}
+}
+
+func TestGetInfluenceExps(t *testing.T) {
+	testPMData := models.Pressuremeter{
+		Exps: []models.PressuremeterExp{
+			{Depth: 0.5, LimitPressure: 30, NetLimitPressure: 28},
+			{Depth: 2, LimitPressure: 40, NetLimitPressure: 37},
+			{Depth: 4, LimitPressure: 50, NetLimitPressure: 46},
+			{Depth: 7, LimitPressure: 90, NetLimitPressure: 85},
+		},
+	}
+
+	output := getInfluenceExps(testPMData, 2, 2)
+	expectedDepths := []float64{1, 2, 4, 5}
+	expectedPressures := []float64{33.33, 40, 50, 63.33}
+	expectedInterpolated := []bool{true, false, false, true}
+
+	if len(output) != len(expectedDepths) {
+		t.Fatalf("Expected %d experiments, Actual %d experiments", len(expectedDepths), len(output))
+	}
+	for i, exp := range output {
+		if exp.Depth != expectedDepths[i] || np.Round(exp.LimitPressure, 2) != expectedPressures[i] ||
+			exp.IsInterpolated != expectedInterpolated[i] {
+			t.Errorf(
+				"Expected experiment: %v %v %v, Actual experiment: %v %v %v", expectedDepths[i],
+				expectedPressures[i], expectedInterpolated[i], exp.Depth, exp.LimitPressure, exp.IsInterpolated,
+			)
+		}
+	}
+
+	testPMData.Exps = append(testPMData.Exps[:1], append([]models.PressuremeterExp{
+		{Depth: 1, LimitPressure: 35, NetLimitPressure: 33},
+	}, testPMData.Exps[1:]...)...)
+	output = getInfluenceExps(testPMData, 2, 2)
+	expectedDepths = []float64{1, 2, 4, 5}
+	expectedInterpolated = []bool{false, false, false, true}
+	if len(output) != len(expectedDepths) {
+		t.Fatalf("Expected %d experiments, Actual %d experiments at the zone boundary", len(expectedDepths), len(output))
+	}
+	for i, exp := range output {
+		if exp.Depth != expectedDepths[i] || exp.IsInterpolated != expectedInterpolated[i] {
+			t.Errorf(
+				"Expected experiment: %v %v, Actual experiment: %v %v at the zone boundary", expectedDepths[i],
+				expectedInterpolated[i], exp.Depth, exp.IsInterpolated,
+			)
+		}
+	}
+
+	if output = getInfluenceExps(models.Pressuremeter{}, 2, 2); len(output) != 0 {
+		t.Errorf("Expected no experiments, Actual %d experiments", len(output))
+	}
+}
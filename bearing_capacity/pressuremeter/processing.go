@@ -0,0 +1,167 @@
+package Pressuremeter
+
+import (
+	"math"
+
+	pkg "github.com/geoport/GeoGo/internal"
+	"github.com/geoport/GeoGo/models"
+)
+
+// minimum number of readings to define the pseudo-elastic and plastic ranges
+const minReadings = 3
+
+// Poisson's ratio assumed in the calculation of pressuremeter modulus
+const poissonsRatio = 0.33
+
+// segments of the pseudo-elastic range may be this much softer than the stiffest segment
+const pseudoElasticTolerance = 1.5
+
+// calcVolumeLoss returns the volume loss coefficient of the equipment (in cm3 per t/m2) as the slope of the volume
+// calibration readings.
+func calcVolumeLoss(calibration []models.PressuremeterReading) float64 {
+	if len(calibration) < 2 {
+		return 0
+	}
+	pressures := make([]float64, len(calibration))
+	volumes := make([]float64, len(calibration))
+	for i, reading := range calibration {
+		pressures[i] = reading.Pressure
+		volumes[i] = reading.Volume
+	}
+	slope, _ := pkg.LinearFit(pressures, volumes)
+	return slope
+}
+
+// calcMembraneResistance returns the pressure needed to inflate the membrane in air to the given volume.
+func calcMembraneResistance(calibration []models.PressuremeterReading, volume float64) float64 {
+	if len(calibration) == 0 {
+		return 0
+	}
+	pressures := make([]float64, len(calibration))
+	volumes := make([]float64, len(calibration))
+	for i, reading := range calibration {
+		pressures[i] = reading.Pressure
+		volumes[i] = reading.Volume
+	}
+	return pkg.Interp(volume, volumes, pressures)
+}
+
+// correctReadings applies the hydrostatic, membrane and volume loss corrections to the raw readings.
+func correctReadings(test models.PressuremeterRawTest, volumeLoss float64) []models.PressuremeterReading {
+	// hydrostatic pressure of the water column between the gauge and the probe in t/m2
+	hydrostatic := test.Depth + test.GaugeHeight
+
+	corrected := make([]models.PressuremeterReading, len(test.Readings))
+	for i, reading := range test.Readings {
+		volume := reading.Volume - volumeLoss*reading.Pressure
+		pressure := reading.Pressure + hydrostatic - calcMembraneResistance(test.MembraneCalibration, reading.Volume)
+		corrected[i] = models.PressuremeterReading{Pressure: pressure, Volume: volume}
+	}
+	return corrected
+}
+
+// findPseudoElasticRange returns the indexes of the first and last readings of the pseudo-elastic range. The range
+// starts from the stiffest segment of the curve and is extended to the neighbouring segments as long as they are
+// not much softer.
+func findPseudoElasticRange(readings []models.PressuremeterReading) (int, int) {
+	slopes := make([]float64, len(readings)-1)
+	minIndex := 0
+	for i := range slopes {
+		dp := readings[i+1].Pressure - readings[i].Pressure
+		if dp <= 0 {
+			slopes[i] = math.Inf(1)
+		} else {
+			slopes[i] = (readings[i+1].Volume - readings[i].Volume) / dp
+		}
+		if slopes[i] < slopes[minIndex] {
+			minIndex = i
+		}
+	}
+
+	limit := pseudoElasticTolerance * slopes[minIndex]
+	start, end := minIndex, minIndex
+	for start > 0 && slopes[start-1] <= limit {
+		start--
+	}
+	for end < len(slopes)-1 && slopes[end+1] <= limit {
+		end++
+	}
+	return start, end + 1
+}
+
+// calcLimitPressure extrapolates the readings after the creep pressure linearly on the pressure - inverse volume
+// plane up to the volume at which the initial cavity volume is doubled.
+func calcLimitPressure(readings []models.PressuremeterReading, end int, probeVolume, V1 float64) float64 {
+	plastic := readings[end:]
+	if len(plastic) < 2 {
+		return readings[len(readings)-1].Pressure
+	}
+
+	inverseVolumes := make([]float64, len(plastic))
+	pressures := make([]float64, len(plastic))
+	for i, reading := range plastic {
+		inverseVolumes[i] = 1 / reading.Volume
+		pressures[i] = reading.Pressure
+	}
+	slope, intercept := pkg.LinearFit(inverseVolumes, pressures)
+
+	return slope/(probeVolume+2*V1) + intercept
+}
+
+// calcInSituPressure returns the at-rest total horizontal stress at the test depth using Jaky's coefficient.
+func calcInSituPressure(soilProfile models.SoilProfile, depth float64) float64 {
+	layer := soilProfile.Layers[soilProfile.GetLayerIndex(depth)]
+	K0 := 1 - math.Sin(pkg.Radian(layer.EffectiveFrictionAngle))
+
+	var porePressure float64
+	if depth > soilProfile.Gwt {
+		porePressure = depth - soilProfile.Gwt
+	}
+	return K0*soilProfile.CalcEffectiveStress(depth) + porePressure
+}
+
+// ProcessTest derives the pressuremeter modulus, creep pressure and limit pressure from the raw readings of a
+// pressuremeter test.
+//
+// Parameters:
+//
+// - test (models.PressuremeterRawTest): Raw readings and calibrations of the test. Pressures are in t/m2.
+//
+// - soilProfile (models.SoilProfile): The soil profile that is used to calculate the in-situ horizontal pressure.
+//
+// Returns:
+//
+// - result (ProcessingResult): The processed test where Exp holds the derived parameters. A zero result is returned
+// if the test has fewer than 3 readings.
+func ProcessTest(test models.PressuremeterRawTest, soilProfile models.SoilProfile) ProcessingResult {
+	if len(test.Readings) < minReadings {
+		return ProcessingResult{}
+	}
+	volumeLoss := calcVolumeLoss(test.VolumeCalibration)
+	readings := correctReadings(test, volumeLoss)
+
+	start, end := findPseudoElasticRange(readings)
+	p1, V1 := readings[start].Pressure, readings[start].Volume
+	p2, V2 := readings[end].Pressure, readings[end].Volume
+
+	Vm := (V1 + V2) / 2
+	EM := 2 * (1 + poissonsRatio) * (test.ProbeVolume + Vm) * (p2 - p1) / (V2 - V1)
+
+	limitPressure := calcLimitPressure(readings, end, test.ProbeVolume, V1)
+	inSituPressure := calcInSituPressure(soilProfile, test.Depth)
+
+	return ProcessingResult{
+		Exp: models.PressuremeterExp{
+			Depth:            test.Depth,
+			LimitPressure:    limitPressure,
+			NetLimitPressure: limitPressure - inSituPressure,
+			EM:               EM,
+			CreepPressure:    p2,
+		},
+		CorrectedReadings:  readings,
+		VolumeLoss:         volumeLoss,
+		PseudoElasticStart: start,
+		PseudoElasticEnd:   end,
+		InSituPressure:     inSituPressure,
+	}
+}
@@ -0,0 +1,211 @@
+package Pressuremeter
+
+import (
+	"math"
+	"strings"
+
+	pkg "github.com/geoport/GeoGo/internal"
+	"github.com/geoport/GeoGo/models"
+)
+
+// reference width of Menard's method in meters
+const referenceWidth = 0.6
+
+// getShapeCoefficients returns Menard's shape coefficients λc and λd for the given length to width ratio.
+func getShapeCoefficients(foundationData models.Foundation) (float64, float64) {
+	if foundationData.FoundationType == "round" {
+		return 1, 1
+	}
+	ratios := []float64{1, 2, 3, 5, 20}
+	lambdaC := []float64{1.1, 1.2, 1.3, 1.4, 1.5}
+	lambdaD := []float64{1.12, 1.53, 1.78, 2.14, 2.65}
+
+	LB := foundationData.FoundationLength / foundationData.FoundationWidth
+	if foundationData.FoundationType == "strip" {
+		LB = 20
+	}
+	return pkg.Interp(LB, ratios, lambdaC), pkg.Interp(LB, ratios, lambdaD)
+}
+
+// getSoilType returns the soil type of Menard's rheological factor table for the given USCS class.
+func getSoilType(soilClass string) string {
+	soilClass = strings.ToUpper(soilClass)
+	switch {
+	case soilClass == "PT" || strings.HasPrefix(soilClass, "O"):
+		return "peat"
+	case strings.HasPrefix(soilClass, "C"):
+		return "clay"
+	case strings.HasPrefix(soilClass, "M"):
+		return "silt"
+	case strings.HasPrefix(soilClass, "S"):
+		return "sand"
+	case strings.HasPrefix(soilClass, "G"):
+		return "gravel"
+	default:
+		return "rock"
+	}
+}
+
+// getAlpha returns Menard's rheological factor for the given soil type and EM/pl ratio.
+//
+// Parameters:
+//
+// - soilType (string): "peat", "clay", "silt", "sand", "gravel" or "rock".
+//
+// - ratio (float64): Ratio of the pressuremeter modulus to the limit pressure.
+//
+// Returns:
+//
+// - alpha (float64)
+func getAlpha(soilType string, ratio float64) float64 {
+	switch soilType {
+	case "peat":
+		return 1
+	case "clay":
+		if ratio > 16 {
+			return 1
+		} else if ratio >= 9 {
+			return 2. / 3
+		}
+		return 0.5
+	case "silt":
+		if ratio > 14 {
+			return 2. / 3
+		}
+		return 0.5
+	case "sand":
+		if ratio > 12 {
+			return 0.5
+		}
+		return 1. / 3
+	case "gravel":
+		if ratio > 10 {
+			return 1. / 3
+		}
+		return 0.25
+	default:
+		return 0.5
+	}
+}
+
+// interpExps returns the value of the pressuremeter experiments at the given depth by linear interpolation.
+func interpExps(psData models.Pressuremeter, depth float64, value func(exp models.PressuremeterExp) float64) float64 {
+	depths := make([]float64, len(psData.Exps))
+	values := make([]float64, len(psData.Exps))
+	for i, exp := range psData.Exps {
+		depths[i] = exp.Depth
+		values[i] = value(exp)
+	}
+	return pkg.Interp(depth, depths, values)
+}
+
+// harmonicMean returns the harmonic mean of the values.
+func harmonicMean(values []float64) float64 {
+	var sum float64
+	for _, v := range values {
+		sum += 1 / v
+	}
+	return float64(len(values)) / sum
+}
+
+// calcModuli returns the pressuremeter moduli of the sixteen B/2 thick slices below the foundation and the number of
+// slices covered by the experiments. It returns nil if there are no experiments.
+func calcModuli(psData models.Pressuremeter, Df, B float64) ([]float64, int) {
+	if len(psData.Exps) == 0 {
+		return nil, 0
+	}
+	deepest := psData.Exps[len(psData.Exps)-1].Depth
+
+	moduli := make([]float64, 16)
+	known := 0
+	for i := range moduli {
+		center := Df + (float64(i)+0.5)*B/2
+		moduli[i] = interpExps(psData, center, func(exp models.PressuremeterExp) float64 { return exp.EM })
+		if center <= deepest+B/2 {
+			known = i + 1
+		}
+	}
+	return moduli, known
+}
+
+// calcDeviatoricModulus returns the equivalent modulus of the deviatoric zone. When the experiments do not cover
+// slices 6 to 16, the reduced forms of Menard's expression are used.
+func calcDeviatoricModulus(moduli []float64, known int) float64 {
+	sum := 1/moduli[0] + 1/(0.85*moduli[1]) + 1/harmonicMean(moduli[2:5])
+	coefficient := 3.2
+	if known >= 8 {
+		sum += 1 / (2.5 * harmonicMean(moduli[5:8]))
+		coefficient = 3.6
+	}
+	if known >= 16 {
+		sum += 1 / (2.5 * harmonicMean(moduli[8:16]))
+		coefficient = 4
+	}
+	return coefficient / sum
+}
+
+// CalcSettlement calculates the settlement of a shallow foundation by Menard's pressuremeter method as the sum of the
+// deviatoric and spherical components.
+//
+// Parameters:
+//
+// - soilProfile (models.SoilProfile): The soil profile to be analyzed.
+//
+// - foundationData (models.Foundation): The foundation data to be analyzed.
+//
+// - foundationPressure (float64): Pressure applied by the foundation (in t/m2).
+//
+// - psData (models.Pressuremeter): Pressuremeter experiments with pressuremeter moduli.
+//
+// Returns:
+//
+// - result (SettlementResult): A zero result is returned if there are no experiments or the pressuremeter modulus of
+// any slice is not positive.
+func CalcSettlement(
+	soilProfile models.SoilProfile, foundationData models.Foundation, foundationPressure float64,
+	psData models.Pressuremeter,
+) SettlementResult {
+	Df := foundationData.FoundationDepth
+	B := foundationData.FoundationWidth
+
+	moduli, known := calcModuli(psData, Df, B)
+	if moduli == nil {
+		return SettlementResult{}
+	}
+	for _, modulus := range moduli {
+		if modulus <= 0 {
+			return SettlementResult{}
+		}
+	}
+
+	netPressure := math.Max(foundationPressure-soilProfile.CalcNormalStress(Df), 0)
+	lambdaC, lambdaD := getShapeCoefficients(foundationData)
+
+	Ec := moduli[0]
+	Ed := calcDeviatoricModulus(moduli, known)
+
+	layer := soilProfile.Layers[soilProfile.GetLayerIndex(Df)]
+	pl := interpExps(psData, Df+B/4, func(exp models.PressuremeterExp) float64 { return exp.LimitPressure })
+	alpha := getAlpha(getSoilType(layer.SoilClass), Ec/pl)
+
+	var deviatoric float64
+	if B > referenceWidth {
+		deviatoric = 2 / (9 * Ed) * netPressure * referenceWidth * math.Pow(lambdaD*B/referenceWidth, alpha)
+	} else {
+		deviatoric = 2 / (9 * Ed) * netPressure * lambdaD * B
+	}
+	spherical := alpha / (9 * Ec) * netPressure * lambdaC * B
+
+	return SettlementResult{
+		NetPressure:          netPressure,
+		Alpha:                alpha,
+		LambdaC:              lambdaC,
+		LambdaD:              lambdaD,
+		Moduli:               moduli,
+		SphericalModulus:     Ec,
+		DeviatoricModulus:    Ed,
+		SphericalSettlement:  spherical * 100,
+		DeviatoricSettlement: deviatoric * 100,
+		Settlement:           (spherical + deviatoric) * 100,
+	}
+}
@@ -1,9 +1,44 @@
 package Pressuremeter
 
+import "github.com/geoport/GeoGo/models"
+
 type Result struct {
-	Kp                       float64 `json:"kp"`
-	AllowableBearingCapacity float64 `json:"allowableBearingCapacity"`
-	IsSafe                   bool    `json:"isSafe"`
-	EffectivePressure        float64 `json:"effectivePressure"`
-	NetEffectivePressure     float64 `json:"netEffectivePressure"`
+	Kp                       float64        `json:"kp"`
+	AllowableBearingCapacity float64        `json:"allowableBearingCapacity"`
+	IsSafe                   bool           `json:"isSafe"`
+	EffectivePressure        float64        `json:"effectivePressure"`
+	NetEffectivePressure     float64        `json:"netEffectivePressure"`
+	InfluenceZoneTop         float64        `json:"influenceZoneTop"`    // meter
+	InfluenceZoneBottom      float64        `json:"influenceZoneBottom"` // meter
+	InfluenceExps            []InfluenceExp `json:"influenceExps"`
+}
+
+// InfluenceExp is an experiment that is used in the calculation of the equivalent limit pressure.
+type InfluenceExp struct {
+	Depth            float64 `json:"depth"`
+	LimitPressure    float64 `json:"limitPressure"`
+	NetLimitPressure float64 `json:"netLimitPressure"`
+	IsInterpolated   bool    `json:"isInterpolated"`
+}
+
+type SettlementResult struct {
+	NetPressure          float64   `json:"netPressure"` // t/m2
+	Alpha                float64   `json:"alpha"`
+	LambdaC              float64   `json:"lambdaC"`
+	LambdaD              float64   `json:"lambdaD"`
+	Moduli               []float64 `json:"moduli"`               // t/m2, modulus of each B/2 thick slice below the foundation
+	SphericalModulus     float64   `json:"sphericalModulus"`     // t/m2
+	DeviatoricModulus    float64   `json:"deviatoricModulus"`    // t/m2
+	SphericalSettlement  float64   `json:"sphericalSettlement"`  // cm
+	DeviatoricSettlement float64   `json:"deviatoricSettlement"` // cm
+	Settlement           float64   `json:"settlement"`           // cm
+}
+
+type ProcessingResult struct {
+	Exp                models.PressuremeterExp       `json:"exp"`
+	CorrectedReadings  []models.PressuremeterReading `json:"correctedReadings"`
+	VolumeLoss         float64                       `json:"volumeLoss"`         // cm^3 per t/m2
+	PseudoElasticStart int                           `json:"pseudoElasticStart"` // index of the corrected readings
+	PseudoElasticEnd   int                           `json:"pseudoElasticEnd"`   // index of the corrected readings
+	InSituPressure     float64                       `json:"inSituPressure"`     // t/m2
 }
@@ -0,0 +1,118 @@
+package rock
+
+import (
+	"math"
+
+	"github.com/geoport/GeoGo/models"
+)
+
+// safety factor applied to Carter & Kulhawy's lower bound solution
+const safetyFactor = 3.
+
+// resistance factor of AASHTO LRFD Table 10.5.5.2.2-1 for footings on rock
+const resistanceFactor = 0.45
+
+// getUCS returns the uniaxial compressive strength of the intact rock. If it is not given, it is estimated from the
+// point load index of the layer using the layer's Kp, or 24 if Kp is not given.
+func getUCS(rockMass models.RockMass, layer models.SoilLayer) float64 {
+	if rockMass.UCS > 0 {
+		return rockMass.UCS
+	}
+	return layer.EstimateUCS(layer.IS50)
+}
+
+// getGSI returns the geological strength index of the rock mass. If it is not given, it is estimated from RMR89
+// (GSI = RMR89 - 5) or from the joint condition rating and RQD of the layer (Hoek et al., 2013).
+func getGSI(rockMass models.RockMass, layer models.SoilLayer) float64 {
+	if rockMass.GSI > 0 {
+		return rockMass.GSI
+	}
+	if rockMass.RMR > 0 {
+		return math.Max(rockMass.RMR-5, 0)
+	}
+	return 1.5*rockMass.JointCondition + layer.RQD/2
+}
+
+// calcHoekBrownParams calculates the generalized Hoek-Brown (2002) parameters of the rock mass.
+//
+// Parameters:
+//
+// - GSI (float64): Geological strength index.
+//
+// - mi (float64): Hoek-Brown constant of intact rock.
+//
+// - D (float64): Disturbance factor.
+//
+// Returns:
+//
+// - mb (float64)
+//
+// - s (float64)
+//
+// - a (float64)
+//
+// Usage:
+//
+// mb, s, a := calcHoekBrownParams(50, 10, 0)
+func calcHoekBrownParams(GSI, mi, D float64) (float64, float64, float64) {
+	mb := mi * math.Exp((GSI-100)/(28-14*D))
+	s := math.Exp((GSI - 100) / (9 - 3*D))
+	a := 0.5 + (math.Exp(-GSI/15)-math.Exp(-20./3))/6
+
+	return mb, s, a
+}
+
+// calcUltimateBearingCapacity returns the lower bound bearing capacity of Carter & Kulhawy (1988) generalized for
+// the Hoek-Brown exponent a.
+func calcUltimateBearingCapacity(UCS, mb, s, a float64) float64 {
+	return UCS * (math.Pow(s, a) + math.Pow(mb*math.Pow(s, a)+s, a))
+}
+
+// CalcBearingCapacity is a function that calculates the bearing capacity of a foundation on a rock mass using the
+// Hoek-Brown failure criterion by Carter & Kulhawy and AASHTO LRFD 10.6.3.2.
+//
+// Parameters:
+//
+// - soilProfile (models.SoilProfile): The soil profile to be analyzed. RQD, IS50 and Kp of the foundation layer are
+// used when the rock mass properties are not given.
+//
+// - foundationData (models.Foundation): The foundation data to be analyzed.
+//
+// - foundationPressure (float64): Pressure applied by the foundation (in t/m2).
+//
+// - rockMass (models.RockMass): Properties of the rock mass.
+//
+// Returns:
+//
+// - result (Result)
+func CalcBearingCapacity(
+	soilProfile models.SoilProfile, foundationData models.Foundation, foundationPressure float64,
+	rockMass models.RockMass,
+) Result {
+	Df := foundationData.FoundationDepth
+	layer := soilProfile.Layers[soilProfile.GetLayerIndex(Df)]
+
+	UCS := getUCS(rockMass, layer)
+	GSI := getGSI(rockMass, layer)
+	mb, s, a := calcHoekBrownParams(GSI, rockMass.Mi, rockMass.Disturbance)
+
+	ultimateBearingCapacity := calcUltimateBearingCapacity(UCS, mb, s, a)
+	allowableBearingCapacity := ultimateBearingCapacity / safetyFactor
+
+	return Result{
+		UCS:       UCS,
+		GSI:       GSI,
+		HoekBrown: HoekBrown{Mb: mb, S: s, A: a},
+		CarterKulhawy: CarterKulhawy{
+			UltimateBearingCapacity: ultimateBearingCapacity,
+			SafetyFactor:            safetyFactor,
+		},
+		AASHTO: AASHTO{
+			NominalBearingResistance:  ultimateBearingCapacity,
+			ResistanceFactor:          resistanceFactor,
+			FactoredBearingResistance: resistanceFactor * ultimateBearingCapacity,
+		},
+		AllowableBearingCapacity: allowableBearingCapacity,
+		IsSafe:                   allowableBearingCapacity >= foundationPressure,
+	}
+}
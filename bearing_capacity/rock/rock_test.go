@@ -0,0 +1,52 @@
+package rock
+
+import (
+	"testing"
+
+	dt "github.com/geoport/GeoGo/data"
+	pkg "github.com/geoport/GeoGo/internal"
+	"github.com/geoport/GeoGo/models"
+)
+
+func TestCalcHoekBrownParams(t *testing.T) {
+	mb, s, a := calcHoekBrownParams(50, 10, 0)
+	output := []float64{mb, s, a}
+	expected := []float64{1.677, 0.00387, 0.506}
+
+	if !pkg.AssertFloatArray(output, expected, 0.001) {
+		t.Errorf("Got %v, want %v", output, expected)
+	}
+}
+
+func TestGetGSI(t *testing.T) {
+	layer := models.SoilLayer{RQD: 30}
+	rockMasses := []models.RockMass{{GSI: 45}, {RMR: 60}, {JointCondition: 15}}
+	expected := []float64{45, 55, 37.5}
+
+	for i, rockMass := range rockMasses {
+		output := getGSI(rockMass, layer)
+		if output != expected[i] {
+			t.Errorf("Got %v, want %v for GSI", output, expected[i])
+		}
+	}
+}
+
+func TestCalcBearingCapacity(t *testing.T) {
+	soilProfile := dt.SoilProfile.Copy()
+	soilProfile.CalcLayerDepths()
+	rockMass := models.RockMass{Mi: 10, JointCondition: 15}
+
+	expectedUCS := 1713.18
+	expectedUltimate := 337.73
+	output := CalcBearingCapacity(soilProfile, dt.FoundationData, 50, rockMass)
+
+	if !pkg.AssertFloat(output.UCS, expectedUCS, 0.01) {
+		t.Errorf("Got %v, want %v for UCS", output.UCS, expectedUCS)
+	}
+	if !pkg.AssertFloat(output.CarterKulhawy.UltimateBearingCapacity, expectedUltimate, 0.01) {
+		t.Errorf("Got %v, want %v for ultimate bearing capacity", output.CarterKulhawy.UltimateBearingCapacity, expectedUltimate)
+	}
+	if !output.IsSafe {
+		t.Errorf("Expected the foundation to be safe")
+	}
+}
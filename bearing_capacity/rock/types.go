@@ -0,0 +1,28 @@
+package rock
+
+type Result struct {
+	UCS                      float64       `json:"UCS"` // t/m2
+	GSI                      float64       `json:"GSI"`
+	HoekBrown                HoekBrown     `json:"hoekBrown"`
+	CarterKulhawy            CarterKulhawy `json:"carterKulhawy"`
+	AASHTO                   AASHTO        `json:"AASHTO"`
+	AllowableBearingCapacity float64       `json:"allowableBearingCapacity"`
+	IsSafe                   bool          `json:"isSafe"`
+}
+
+type HoekBrown struct {
+	Mb float64 `json:"mb"`
+	S  float64 `json:"s"`
+	A  float64 `json:"a"`
+}
+
+type CarterKulhawy struct {
+	UltimateBearingCapacity float64 `json:"ultimateBearingCapacity"` // t/m2
+	SafetyFactor            float64 `json:"safetyFactor"`
+}
+
+type AASHTO struct {
+	NominalBearingResistance  float64 `json:"nominalBearingResistance"` // t/m2
+	ResistanceFactor          float64 `json:"resistanceFactor"`
+	FactoredBearingResistance float64 `json:"factoredBearingResistance"` // t/m2
+}
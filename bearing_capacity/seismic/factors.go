@@ -0,0 +1,171 @@
+package seismic
+
+import (
+	"math"
+
+	pkg "github.com/geoport/GeoGo/internal"
+)
+
+// calcInertiaAngle returns the seismic inertia angle θ = atan(kh / (1 - kv)) in degrees.
+func calcInertiaAngle(kh, kv float64) float64 {
+	return math.Atan(kh/(1-kv)) * 180 / math.Pi
+}
+
+// calcRichardsFactors calculates the bearing capacity factors of the Coulomb type mechanism of Richards, Elms &
+// Budhu (1993). The interface friction between the active and passive wedges is taken as φ/2.
+//
+// Parameters:
+//
+// - phi (float64): Angle of internal friction of the soil (in degrees).
+//
+// - theta (float64): Seismic inertia angle (in degrees).
+//
+// Returns:
+//
+// - Nc (float64)
+//
+// - Nq (float64)
+//
+// - Ng (float64)
+//
+// Usage:
+//
+// Nc, Nq, Ng := calcRichardsFactors(30, 10)
+func calcRichardsFactors(phi, theta float64) (float64, float64, float64) {
+	if phi <= theta {
+		return 0, 0, 0
+	}
+	delta := phi / 2
+
+	phiRad := pkg.Radian(phi)
+	thetaRad := pkg.Radian(theta)
+	deltaRad := pkg.Radian(delta)
+
+	root := math.Sqrt(math.Sin(phiRad+deltaRad) * math.Sin(phiRad-thetaRad) / math.Cos(deltaRad+thetaRad))
+	denominator := math.Cos(thetaRad) * math.Cos(deltaRad+thetaRad)
+	Kae := math.Pow(math.Cos(phiRad-thetaRad), 2) / (denominator * math.Pow(1+root, 2))
+	Kpe := math.Pow(math.Cos(phiRad-thetaRad), 2) / (denominator * math.Pow(1-root, 2))
+
+	tanPT := math.Tan(phiRad - thetaRad)
+	cotPT := 1 / tanPT
+	C1 := math.Sqrt(tanPT * (tanPT + cotPT) * (1 + math.Tan(deltaRad+thetaRad)*cotPT))
+	C2 := 1 + math.Tan(deltaRad+thetaRad)*(tanPT+cotPT)
+	rhoA := phiRad - thetaRad + math.Atan((-tanPT+C1)/C2)
+
+	Nq := Kpe / Kae
+	Ng := math.Tan(rhoA) * (Kpe/Kae - 1)
+	Nc := (Nq - 1) / math.Tan(phiRad)
+
+	return Nc, Nq, Ng
+}
+
+// calcRichardsReductionFactors returns the ratios of the seismic to static bearing capacity factors of Richards
+// et al. The surcharge and unit weight terms are also scaled by (1 - kv). Since the mechanism is not defined for
+// φ = 0, the cohesion term is not reduced for purely cohesive soils.
+//
+// Parameters:
+//
+// - phi (float64): Angle of internal friction of the soil (in degrees).
+//
+// - kh (float64): Horizontal seismic coefficient.
+//
+// - kv (float64): Vertical seismic coefficient.
+//
+// Returns:
+//
+// - Ec (float64)
+//
+// - Eq (float64)
+//
+// - Eg (float64)
+//
+// Usage:
+//
+// Ec, Eq, Eg := calcRichardsReductionFactors(30, 0.2, 0)
+func calcRichardsReductionFactors(phi, kh, kv float64) (float64, float64, float64) {
+	if phi == 0 {
+		return 1, 1 - kv, 1 - kv
+	}
+	theta := calcInertiaAngle(kh, kv)
+
+	NcS, NqS, NgS := calcRichardsFactors(phi, 0)
+	NcE, NqE, NgE := calcRichardsFactors(phi, theta)
+
+	return NcE / NcS, (1 - kv) * NqE / NqS, (1 - kv) * NgE / NgS
+}
+
+// calcBudhuAlKarniReductionFactors returns the seismic reduction factors of Budhu & Al-Karni (1993).
+//
+// Parameters:
+//
+// - phi (float64): Angle of internal friction of the soil (in degrees).
+//
+// - cohesion (float64): Cohesion of the soil (in t/m2).
+//
+// - unitWeight (float64): Unit weight of the soil (in t/m3).
+//
+// - B (float64): Width of the foundation (in meters).
+//
+// - kh (float64): Horizontal seismic coefficient.
+//
+// - kv (float64): Vertical seismic coefficient.
+//
+// Returns:
+//
+// - Ec (float64)
+//
+// - Eq (float64)
+//
+// - Eg (float64)
+//
+// Usage:
+//
+// Ec, Eq, Eg := calcBudhuAlKarniReductionFactors(30, 1, 1.8, 2, 0.2, 0)
+func calcBudhuAlKarniReductionFactors(phi, cohesion, unitWeight, B, kh, kv float64) (float64, float64, float64) {
+	// depth of the failure zone
+	H := 0.5 * B * math.Tan(pkg.Radian(45+phi/2))
+
+	var D float64
+	if unitWeight > 0 && H > 0 {
+		D = cohesion / (unitWeight * H)
+	}
+
+	Ec := math.Exp(-4.3 * math.Pow(kh, 1+D))
+	Eq := (1 - kv) * math.Exp(-5.3*math.Pow(kh, 1.2)/(1-kv))
+	Eg := (1 - 2*kv/3) * math.Exp(-9*math.Pow(kh, 1.2)/(1-kv))
+
+	return Ec, Eq, Eg
+}
+
+// calcPaolucciPeckerReductionFactors returns the soil inertia reduction factors of Paolucci & Pecker (1997). The
+// method neglects the vertical seismic coefficient and the effect of soil inertia on the cohesion term.
+//
+// Parameters:
+//
+// - phi (float64): Angle of internal friction of the soil (in degrees).
+//
+// - kh (float64): Horizontal seismic coefficient.
+//
+// Returns:
+//
+// - Ec (float64)
+//
+// - Eq (float64)
+//
+// - Eg (float64)
+//
+// Usage:
+//
+// Ec, Eq, Eg := calcPaolucciPeckerReductionFactors(30, 0.2)
+func calcPaolucciPeckerReductionFactors(phi, kh float64) (float64, float64, float64) {
+	if phi == 0 {
+		return 1, 1, 1
+	}
+	ratio := 1 - kh/math.Tan(pkg.Radian(phi))
+	if ratio <= 0 {
+		return 1, 0, 0
+	}
+	Eq := math.Pow(ratio, 0.35)
+
+	return 1, Eq, Eq
+}
@@ -0,0 +1,146 @@
+package seismic
+
+import (
+	"github.com/geoport/GeoGo/bearing_capacity/meyerhof"
+	"github.com/geoport/GeoGo/bearing_capacity/terzaghi"
+	"github.com/geoport/GeoGo/bearing_capacity/vesic"
+)
+
+// staticCapacity holds the parts of a static bearing capacity result that are needed for the seismic reduction.
+type staticCapacity struct {
+	Nc, Nq, Ng          float64
+	partC, partQ, partG float64
+	cohesion            float64
+	phi                 float64
+	unitWeight          float64
+	ultimate            float64
+}
+
+// calcMethodResult applies the reduction factors to the static bearing capacity factors and terms.
+func calcMethodResult(static staticCapacity, Ec, Eq, Eg float64) MethodResult {
+	return MethodResult{
+		ReductionFactors: ReductionFactors{Ec: Ec, Eq: Eq, Eg: Eg},
+		BearingCapacityFactors: BearingCapacityFactors{
+			Nc: static.Nc * Ec,
+			Nq: static.Nq * Eq,
+			Ng: static.Ng * Eg,
+		},
+		UltimateBearingCapacity: static.partC*Ec + static.partQ*Eq + static.partG*Eg,
+	}
+}
+
+// calcSeismicBearingCapacity reduces the static bearing capacity with the methods of Richards et al. (1993),
+// Budhu & Al-Karni (1993) and Paolucci & Pecker (1997).
+func calcSeismicBearingCapacity(static staticCapacity, B, kh, kv float64) Result {
+	richardsEc, richardsEq, richardsEg := calcRichardsReductionFactors(static.phi, kh, kv)
+	budhuEc, budhuEq, budhuEg := calcBudhuAlKarniReductionFactors(
+		static.phi, static.cohesion, static.unitWeight, B, kh, kv,
+	)
+	paolucciEc, paolucciEq, paolucciEg := calcPaolucciPeckerReductionFactors(static.phi, kh)
+
+	return Result{
+		Static: StaticResult{
+			BearingCapacityFactors:  BearingCapacityFactors{Nc: static.Nc, Nq: static.Nq, Ng: static.Ng},
+			UltimateBearingCapacity: static.ultimate,
+		},
+		Richards:       calcMethodResult(static, richardsEc, richardsEq, richardsEg),
+		BudhuAlKarni:   calcMethodResult(static, budhuEc, budhuEq, budhuEg),
+		PaolucciPecker: calcMethodResult(static, paolucciEc, paolucciEq, paolucciEg),
+		InertiaAngle:   calcInertiaAngle(kh, kv),
+		Kh:             kh,
+		Kv:             kv,
+	}
+}
+
+// ReduceTerzaghi calculates the seismic bearing capacity from the result of Terzaghi's method.
+//
+// Parameters:
+//
+// - result (terzaghi.Result): The static bearing capacity result.
+//
+// - B (float64): Width of the foundation (in meters).
+//
+// - kh (float64): Horizontal seismic coefficient.
+//
+// - kv (float64): Vertical seismic coefficient.
+//
+// Returns:
+//
+// - result (Result): Seismically reduced bearing capacity factors and ultimate bearing capacity.
+func ReduceTerzaghi(result terzaghi.Result, B, kh, kv float64) Result {
+	static := staticCapacity{
+		Nc:         result.BearingCapacityFactors.Nc,
+		Nq:         result.BearingCapacityFactors.Nq,
+		Ng:         result.BearingCapacityFactors.Ng,
+		partC:      result.CapacityTerms.C,
+		partQ:      result.CapacityTerms.Q,
+		partG:      result.CapacityTerms.G,
+		cohesion:   result.SoilParams.Cohesion,
+		phi:        result.SoilParams.FrictionAngle,
+		unitWeight: result.SoilParams.UnitWeight,
+		ultimate:   result.UltimateBearingCapacity,
+	}
+	return calcSeismicBearingCapacity(static, B, kh, kv)
+}
+
+// ReduceMeyerhof calculates the seismic bearing capacity from the result of Meyerhof's method.
+//
+// Parameters:
+//
+// - result (meyerhof.Result): The static bearing capacity result.
+//
+// - B (float64): Width of the foundation (in meters). Use the effective width of the result for eccentric loads.
+//
+// - kh (float64): Horizontal seismic coefficient.
+//
+// - kv (float64): Vertical seismic coefficient.
+//
+// Returns:
+//
+// - result (Result): Seismically reduced bearing capacity factors and ultimate bearing capacity.
+func ReduceMeyerhof(result meyerhof.Result, B, kh, kv float64) Result {
+	static := staticCapacity{
+		Nc:         result.BearingCapacityFactors.Nc,
+		Nq:         result.BearingCapacityFactors.Nq,
+		Ng:         result.BearingCapacityFactors.Ng,
+		partC:      result.CapacityTerms.C,
+		partQ:      result.CapacityTerms.Q,
+		partG:      result.CapacityTerms.G,
+		cohesion:   result.SoilParams.Cohesion,
+		phi:        result.SoilParams.FrictionAngle,
+		unitWeight: result.SoilParams.UnitWeight,
+		ultimate:   result.UltimateBearingCapacity,
+	}
+	return calcSeismicBearingCapacity(static, B, kh, kv)
+}
+
+// ReduceVesic calculates the seismic bearing capacity from the result of Vesic's method.
+//
+// Parameters:
+//
+// - result (vesic.Result): The static bearing capacity result.
+//
+// - B (float64): Width of the foundation (in meters).
+//
+// - kh (float64): Horizontal seismic coefficient.
+//
+// - kv (float64): Vertical seismic coefficient.
+//
+// Returns:
+//
+// - result (Result): Seismically reduced bearing capacity factors and ultimate bearing capacity.
+func ReduceVesic(result vesic.Result, B, kh, kv float64) Result {
+	static := staticCapacity{
+		Nc:         result.BearingCapacityFactors.Nc,
+		Nq:         result.BearingCapacityFactors.Nq,
+		Ng:         result.BearingCapacityFactors.Ng,
+		partC:      result.CapacityTerms.C,
+		partQ:      result.CapacityTerms.Q,
+		partG:      result.CapacityTerms.G,
+		cohesion:   result.SoilParams.Cohesion,
+		phi:        result.SoilParams.FrictionAngle,
+		unitWeight: result.SoilParams.UnitWeight,
+		ultimate:   result.UltimateBearingCapacity,
+	}
+	return calcSeismicBearingCapacity(static, B, kh, kv)
+}
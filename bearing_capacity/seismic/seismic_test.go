@@ -0,0 +1,73 @@
+package seismic
+
+import (
+	"testing"
+
+	"github.com/geoport/GeoGo/bearing_capacity/meyerhof"
+	"github.com/geoport/GeoGo/bearing_capacity/terzaghi"
+	dt "github.com/geoport/GeoGo/data"
+	pkg "github.com/geoport/GeoGo/internal"
+)
+
+func TestCalcRichardsReductionFactors(t *testing.T) {
+	Ec, Eq, Eg := calcRichardsReductionFactors(30, 0, 0)
+	output := []float64{Ec, Eq, Eg}
+	expected := []float64{1, 1, 1}
+	if !pkg.AssertFloatArray(output, expected, 0.001) {
+		t.Errorf("Got %v, want %v for static case", output, expected)
+	}
+
+	Ec, Eq, Eg = calcRichardsReductionFactors(30, 0.2, 0)
+	output = []float64{Ec, Eq, Eg}
+	expected = []float64{0.52, 0.55, 0.35}
+	if !pkg.AssertFloatArray(output, expected, 0.01) {
+		t.Errorf("Got %v, want %v for seismic case", output, expected)
+	}
+}
+
+func TestCalcBudhuAlKarniReductionFactors(t *testing.T) {
+	Ec, Eq, Eg := calcBudhuAlKarniReductionFactors(30, 0, 1.8, 2, 0.2, 0)
+	output := []float64{Ec, Eq, Eg}
+	expected := []float64{0.42, 0.46, 0.27}
+	if !pkg.AssertFloatArray(output, expected, 0.01) {
+		t.Errorf("Got %v, want %v", output, expected)
+	}
+}
+
+func TestCalcPaolucciPeckerReductionFactors(t *testing.T) {
+	Ec, Eq, Eg := calcPaolucciPeckerReductionFactors(30, 0.2)
+	output := []float64{Ec, Eq, Eg}
+	expected := []float64{1, 0.86, 0.86}
+	if !pkg.AssertFloatArray(output, expected, 0.01) {
+		t.Errorf("Got %v, want %v", output, expected)
+	}
+}
+
+func TestReduceTerzaghi(t *testing.T) {
+	soilProfile := dt.SoilProfile.Copy()
+	soilProfile.CalcLayerDepths()
+	foundationData := dt.FoundationData
+
+	static := terzaghi.CalcBearingCapacity(soilProfile, foundationData, 50, "long")
+	result := ReduceTerzaghi(static, foundationData.FoundationWidth, 0.2, 0.1)
+
+	expected := 32.07
+	if !pkg.AssertFloat(result.Richards.UltimateBearingCapacity, expected, 0.01) {
+		t.Errorf("Got %v, want %v", result.Richards.UltimateBearingCapacity, expected)
+	}
+	if result.Static.UltimateBearingCapacity != static.UltimateBearingCapacity {
+		t.Errorf("Got %v, want %v for static capacity", result.Static.UltimateBearingCapacity, static.UltimateBearingCapacity)
+	}
+}
+
+func TestReduceMeyerhof(t *testing.T) {
+	soilProfile := dt.SoilProfile.Copy()
+	soilProfile.CalcLayerDepths()
+
+	static := meyerhof.CalcBearingCapacity(soilProfile, dt.FoundationData, dt.LoadData, "long")
+	result := ReduceMeyerhof(static, static.EffectiveWidth, 0, 0)
+
+	if !pkg.AssertFloat(result.BudhuAlKarni.UltimateBearingCapacity, static.UltimateBearingCapacity, 0.001) {
+		t.Errorf("Got %v, want %v", result.BudhuAlKarni.UltimateBearingCapacity, static.UltimateBearingCapacity)
+	}
+}
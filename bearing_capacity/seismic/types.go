@@ -0,0 +1,34 @@
+package seismic
+
+type Result struct {
+	Static         StaticResult `json:"static"`
+	Richards       MethodResult `json:"richards"`
+	BudhuAlKarni   MethodResult `json:"budhuAlKarni"`
+	PaolucciPecker MethodResult `json:"paolucciPecker"`
+	InertiaAngle   float64      `json:"inertiaAngle"` // degrees
+	Kh             float64      `json:"kh"`
+	Kv             float64      `json:"kv"`
+}
+
+type StaticResult struct {
+	BearingCapacityFactors  BearingCapacityFactors `json:"bearingCapacityFactors"`
+	UltimateBearingCapacity float64                `json:"ultimateBearingCapacity"`
+}
+
+type MethodResult struct {
+	ReductionFactors        ReductionFactors       `json:"reductionFactors"`
+	BearingCapacityFactors  BearingCapacityFactors `json:"bearingCapacityFactors"`
+	UltimateBearingCapacity float64                `json:"ultimateBearingCapacity"`
+}
+
+type BearingCapacityFactors struct {
+	Nq float64 `json:"Nq"`
+	Nc float64 `json:"Nc"`
+	Ng float64 `json:"Ng"`
+}
+
+type ReductionFactors struct {
+	Eq float64 `json:"Eq"`
+	Ec float64 `json:"Ec"`
+	Eg float64 `json:"Eg"`
+}
@@ -0,0 +1,230 @@
+package slope
+
+import (
+	"math"
+
+	pkg "github.com/geoport/GeoGo/internal"
+)
+
+// setbackChart is a digitized family of curves where each curve belongs to a slope angle and gives the chart value
+// against the setback ratio (b/B, or b/H for Meyerhof's cohesive charts with Ns > 0).
+type setbackChart struct {
+	setbacks    []float64
+	slopeAngles []float64
+	values      [][]float64 // values[i][j] is the value of the i-th slope angle curve at the j-th setback ratio
+}
+
+// value interpolates the chart first along the setback ratio of each curve, then between the slope angle curves.
+func (c setbackChart) value(slopeAngle, setbackRatio float64) float64 {
+	curveValues := make([]float64, len(c.slopeAngles))
+	for i, curve := range c.values {
+		curveValues[i] = pkg.Interp(setbackRatio, c.setbacks, curve)
+	}
+	return pkg.Interp(slopeAngle, c.slopeAngles, curveValues)
+}
+
+// Meyerhof (1957) Nγq charts for strip footings on granular soils (c = 0), digitized for Df/B = 0 and Df/B = 1.
+var meyerhofNgqDepthRatios = []float64{0, 1}
+var meyerhofNgqPhis = []float64{30, 40}
+var meyerhofNgqCharts = [][]setbackChart{
+	// Df/B = 0
+	{
+		{
+			setbacks:    []float64{0, 1, 2, 3, 4, 5, 6},
+			slopeAngles: []float64{0, 20, 30},
+			values: [][]float64{
+				{25, 25, 25, 25, 25, 25, 25},
+				{9, 14, 18, 21, 23, 25, 25},
+				{3, 7, 11, 15, 19, 22, 25},
+			},
+		},
+		{
+			setbacks:    []float64{0, 1, 2, 3, 4, 5, 6},
+			slopeAngles: []float64{0, 20, 40},
+			values: [][]float64{
+				{100, 100, 100, 100, 100, 100, 100},
+				{48, 65, 78, 88, 95, 100, 100},
+				{12, 25, 40, 55, 70, 85, 100},
+			},
+		},
+	},
+	// Df/B = 1
+	{
+		{
+			setbacks:    []float64{0, 1, 2, 3, 4, 5, 6},
+			slopeAngles: []float64{0, 20, 30},
+			values: [][]float64{
+				{60, 60, 60, 60, 60, 60, 60},
+				{38, 48, 55, 60, 60, 60, 60},
+				{25, 37, 47, 55, 60, 60, 60},
+			},
+		},
+		{
+			setbacks:    []float64{0, 1, 2, 3, 4, 5, 6},
+			slopeAngles: []float64{0, 20, 40},
+			values: [][]float64{
+				{230, 230, 230, 230, 230, 230, 230},
+				{150, 185, 210, 225, 230, 230, 230},
+				{80, 125, 165, 200, 225, 230, 230},
+			},
+		},
+	},
+}
+
+// Meyerhof (1957) Ncq charts for strip footings on purely cohesive soils (φ = 0). The charts are digitized for
+// stability numbers Ns = 0, 2 and 4 at Df/B = 0 and for Ns = 0 at Df/B = 1. The Ns = 0 charts are plotted against
+// b/B and the Ns = 2 and 4 charts against b/H.
+var meyerhofNcqStabilityNumbers = []float64{0, 2, 4}
+var meyerhofNcqCharts = []setbackChart{
+	// Ns = 0
+	{
+		setbacks:    []float64{0, 1, 2, 3, 4, 5},
+		slopeAngles: []float64{0, 30, 60, 90},
+		values: [][]float64{
+			{5.14, 5.14, 5.14, 5.14, 5.14, 5.14},
+			{4.3, 4.7, 5.0, 5.14, 5.14, 5.14},
+			{3.5, 4.1, 4.6, 5.0, 5.14, 5.14},
+			{2.6, 3.4, 4.1, 4.6, 5.0, 5.14},
+		},
+	},
+	// Ns = 2
+	{
+		setbacks:    []float64{0, 1, 2, 3, 4, 5},
+		slopeAngles: []float64{0, 30, 60, 90},
+		values: [][]float64{
+			{5.14, 5.14, 5.14, 5.14, 5.14, 5.14},
+			{3.6, 4.1, 4.5, 4.8, 5.0, 5.14},
+			{2.4, 3.1, 3.7, 4.2, 4.6, 5.0},
+			{1.3, 2.2, 3.0, 3.6, 4.2, 4.7},
+		},
+	},
+	// Ns = 4
+	{
+		setbacks:    []float64{0, 1, 2, 3, 4, 5},
+		slopeAngles: []float64{0, 30, 60, 90},
+		values: [][]float64{
+			{5.14, 5.14, 5.14, 5.14, 5.14, 5.14},
+			{2.9, 3.5, 4.0, 4.4, 4.8, 5.0},
+			{1.4, 2.2, 2.9, 3.5, 4.0, 4.5},
+			{0.3, 1.2, 2.0, 2.8, 3.5, 4.1},
+		},
+	},
+}
+var meyerhofNcqDeepChart = setbackChart{
+	setbacks:    []float64{0, 1, 2, 3, 4, 5},
+	slopeAngles: []float64{0, 30, 60, 90},
+	values: [][]float64{
+		{6.3, 6.3, 6.3, 6.3, 6.3, 6.3},
+		{5.9, 6.1, 6.3, 6.3, 6.3, 6.3},
+		{5.5, 5.8, 6.1, 6.3, 6.3, 6.3},
+		{5.0, 5.5, 5.9, 6.2, 6.3, 6.3},
+	},
+}
+
+// Graham, Andrews & Shields (1988) stress characteristics solution for Nγq of strip footings on granular slopes,
+// digitized for Df/B = 0, 0.5 and 1 and φ = 30°, 35°, 40° and 45°. The charts cover setbacks up to b/B = 1.
+var grahamDepthRatios = []float64{0, 0.5, 1}
+var grahamPhis = []float64{30, 35, 40, 45}
+var grahamSetbacks = []float64{0, 0.5, 1}
+var grahamSlopeAngles = []float64{0, 10, 20, 30}
+var grahamValues = [][][][]float64{
+	// Df/B = 0
+	{
+		{{18, 18, 18}, {11, 13, 15}, {5, 8, 11}, {1, 4, 7}},
+		{{40, 40, 40}, {25, 30, 34}, {13, 19, 25}, {5, 11, 17}},
+		{{95, 95, 95}, {60, 72, 81}, {33, 48, 62}, {15, 29, 43}},
+		{{240, 240, 240}, {150, 180, 205}, {85, 125, 160}, {42, 78, 115}},
+	},
+	// Df/B = 0.5
+	{
+		{{34, 34, 34}, {22, 26, 29}, {12, 17, 22}, {4, 10, 15}},
+		{{75, 75, 75}, {50, 58, 65}, {29, 39, 50}, {14, 25, 35}},
+		{{180, 180, 180}, {120, 140, 157}, {72, 97, 122}, {38, 65, 90}},
+		{{450, 450, 450}, {300, 350, 395}, {185, 245, 305}, {100, 165, 225}},
+	},
+	// Df/B = 1
+	{
+		{{52, 52, 52}, {36, 41, 45}, {21, 29, 36}, {9, 18, 27}},
+		{{115, 115, 115}, {80, 91, 100}, {50, 66, 80}, {26, 44, 62}},
+		{{270, 270, 270}, {190, 215, 237}, {120, 158, 192}, {68, 108, 148}},
+		{{680, 680, 680}, {475, 540, 595}, {305, 395, 480}, {175, 270, 370}},
+	},
+}
+
+// calcLevelNg returns Meyerhof's Nγ for level ground. It is used to scale the chart values for friction angles
+// outside the digitized range.
+func calcLevelNg(phi float64) float64 {
+	Nq := math.Exp(math.Pi*math.Tan(pkg.Radian(phi))) * math.Pow(math.Tan(pkg.Radian(45+phi/2)), 2)
+	return (Nq - 1) * math.Tan(pkg.Radian(phi*1.4))
+}
+
+// interpPhi interpolates chart values given at the friction angles of the chart. Since the factors grow
+// exponentially with φ, the interpolation is done on the logarithm of the values. Friction angles outside the
+// chart are scaled from the nearest chart value with the ratio of the level ground Nγ values.
+func interpPhi(phi float64, phis, values []float64) float64 {
+	minPhi := phis[0]
+	maxPhi := phis[len(phis)-1]
+	clampedPhi := math.Min(math.Max(phi, minPhi), maxPhi)
+
+	logValues := make([]float64, len(values))
+	for i, v := range values {
+		logValues[i] = math.Log(math.Max(v, 1e-6))
+	}
+	value := math.Exp(pkg.Interp(clampedPhi, phis, logValues))
+
+	if clampedPhi != phi {
+		value *= calcLevelNg(phi) / calcLevelNg(clampedPhi)
+	}
+	return value
+}
+
+// calcMeyerhofNgq returns Meyerhof's Nγq for the given friction angle, slope angle, setback ratio (b/B) and depth
+// ratio (Df/B).
+func calcMeyerhofNgq(phi, slopeAngle, setbackRatio, depthRatio float64) float64 {
+	depthValues := make([]float64, len(meyerhofNgqDepthRatios))
+	for i, charts := range meyerhofNgqCharts {
+		phiValues := make([]float64, len(meyerhofNgqPhis))
+		for j, chart := range charts {
+			phiValues[j] = chart.value(slopeAngle, setbackRatio)
+		}
+		depthValues[i] = interpPhi(phi, meyerhofNgqPhis, phiValues)
+	}
+	return pkg.Interp(depthRatio, meyerhofNgqDepthRatios, depthValues)
+}
+
+// calcMeyerhofNcq returns Meyerhof's Ncq for the given slope angle, setback ratios (b/B and b/H), depth ratio (Df/B)
+// and stability number (Ns = γH/c). The Ns = 0 chart is read at b/B and the Ns > 0 charts at b/H. Since the
+// Df/B = 1 chart is only available for Ns = 0, the depth effect is applied to the other stability numbers as the
+// ratio of the Ns = 0 charts.
+func calcMeyerhofNcq(slopeAngle, setbackRatio, heightSetbackRatio, depthRatio, stabilityNumber float64) float64 {
+	stabilityValues := make([]float64, len(meyerhofNcqCharts))
+	for i, chart := range meyerhofNcqCharts {
+		if meyerhofNcqStabilityNumbers[i] == 0 {
+			stabilityValues[i] = chart.value(slopeAngle, setbackRatio)
+		} else {
+			stabilityValues[i] = chart.value(slopeAngle, heightSetbackRatio)
+		}
+	}
+	Ncq := pkg.Interp(stabilityNumber, meyerhofNcqStabilityNumbers, stabilityValues)
+
+	shallow := meyerhofNcqCharts[0].value(slopeAngle, setbackRatio)
+	deep := meyerhofNcqDeepChart.value(slopeAngle, setbackRatio)
+	depthFactor := pkg.Interp(depthRatio, []float64{0, 1}, []float64{1, deep / shallow})
+
+	return Ncq * depthFactor
+}
+
+// calcGrahamNgq returns Nγq of Graham et al. for the given friction angle, slope angle, setback ratio (b/B) and
+// depth ratio (Df/B). Setbacks larger than b/B = 1 use the b/B = 1 values, which is conservative.
+func calcGrahamNgq(phi, slopeAngle, setbackRatio, depthRatio float64) float64 {
+	depthValues := make([]float64, len(grahamDepthRatios))
+	for i, phiTables := range grahamValues {
+		phiValues := make([]float64, len(grahamPhis))
+		for j, table := range phiTables {
+			chart := setbackChart{setbacks: grahamSetbacks, slopeAngles: grahamSlopeAngles, values: table}
+			phiValues[j] = chart.value(slopeAngle, setbackRatio)
+		}
+		depthValues[i] = interpPhi(phi, grahamPhis, phiValues)
+	}
+	return pkg.Interp(depthRatio, grahamDepthRatios, depthValues)
+}
@@ -0,0 +1,146 @@
+package slope
+
+import (
+	"math"
+
+	pkg "github.com/geoport/GeoGo/internal"
+)
+
+// calcBearingCapacityFactors returns Hansen's bearing capacity factors (Nc, Nq & Ng) as used by Bowles. For φ = 0,
+// Bowles' Nγ = -2·sin(β) is used to account for the slope.
+//
+// Parameters:
+//
+// - phi (float64): Angle of internal friction of the soil (in degrees).
+//
+// - slopeAngle (float64): Angle of the slope (in degrees).
+//
+// Returns:
+//
+// - Nc (float64)
+//
+// - Nq (float64)
+//
+// - Ng (float64)
+//
+// Usage:
+//
+// Nc, Nq, Ng := calcBearingCapacityFactors(30, 20)
+func calcBearingCapacityFactors(phi, slopeAngle float64) (float64, float64, float64) {
+	if phi == 0 {
+		return 5.14, 1, -2 * math.Sin(pkg.Radian(slopeAngle))
+	}
+	Nq := math.Exp(math.Pi*math.Tan(pkg.Radian(phi))) * math.Pow(math.Tan(pkg.Radian(45+phi/2)), 2)
+	Nc := (Nq - 1) / math.Tan(pkg.Radian(phi))
+	Ng := 1.5 * (Nq - 1) * math.Tan(pkg.Radian(phi))
+
+	return Nc, Nq, Ng
+}
+
+// calcShapeFactors calculates Hansen's shape factors.
+//
+// Parameters:
+//
+// - B (float64): Width of the foundation (in meters).
+//
+// - L (float64): Length of the foundation (in meters).
+//
+// - Nq (float64)
+//
+// - Nc (float64)
+//
+// - phi (float64): Angle of internal friction of the soil (in degrees).
+//
+// Returns:
+//
+// - Sc (float64)
+//
+// - Sq (float64)
+//
+// - Sg (float64)
+//
+// Usage:
+//
+// Sc, Sq, Sg := calcShapeFactors(2.0, 4.0, 18.4, 30.14, 30.0)
+func calcShapeFactors(B, L, Nq, Nc, phi float64) (float64, float64, float64) {
+	Sc := 1 + (B/L)*(Nq/Nc)
+	Sq := 1 + (B/L)*math.Sin(pkg.Radian(phi))
+	Sg := math.Max(1-0.4*B/L, 0.6)
+
+	return Sc, Sq, Sg
+}
+
+// calcDepthFactors calculates Hansen's depth factors.
+//
+// Parameters:
+//
+// - Df (float64): Depth of the foundation (in meters).
+//
+// - B (float64): Width of the foundation (in meters).
+//
+// - phi (float64): Angle of internal friction of the soil (in degrees).
+//
+// Returns:
+//
+// - Dc (float64)
+//
+// - Dq (float64)
+//
+// - Dg (float64)
+//
+// Usage:
+//
+// Dc, Dq, Dg := calcDepthFactors(1.0, 2.0, 30)
+func calcDepthFactors(Df, B, phi float64) (float64, float64, float64) {
+	k := Df / B
+	if k > 1 {
+		k = math.Atan(k)
+	}
+
+	Dc := 1 + 0.4*k
+	Dq := 1 + 2*math.Tan(pkg.Radian(phi))*math.Pow(1-math.Sin(pkg.Radian(phi)), 2)*k
+
+	return Dc, Dq, 1
+}
+
+// calcSetbackRecovery returns the portion of the slope effect that is recovered by setting the foundation back from
+// the crest, from 0 on the crest to 1 on level ground. The slope effect is assumed to vanish at b/B = 6 for surface
+// foundations and at b/B = 2 for Df/B ≥ 1, in line with Meyerhof's charts.
+func calcSetbackRecovery(setbackRatio, depthRatio float64) float64 {
+	setbackLimit := 6 - 4*math.Min(depthRatio, 1)
+	return math.Min(setbackRatio/setbackLimit, 1)
+}
+
+// calcGroundFactors calculates Hansen's ground factors for a foundation on the slope face and moves them towards 1
+// as the foundation is set back from the crest.
+//
+// Parameters:
+//
+// - slopeAngle (float64): Angle of the slope (in degrees).
+//
+// - setbackRatio (float64): Distance between the foundation edge and the slope crest divided by foundation width.
+//
+// - depthRatio (float64): Foundation depth divided by foundation width.
+//
+// Returns:
+//
+// - Gc (float64)
+//
+// - Gq (float64)
+//
+// - Gg (float64)
+//
+// Usage:
+//
+// Gc, Gq, Gg := calcGroundFactors(20, 1, 0.5)
+func calcGroundFactors(slopeAngle, setbackRatio, depthRatio float64) (float64, float64, float64) {
+	Gc := 1 - slopeAngle/147
+	Gq := math.Pow(1-0.5*math.Tan(pkg.Radian(slopeAngle)), 5)
+
+	recovery := calcSetbackRecovery(setbackRatio, depthRatio)
+
+	Gc += (1 - Gc) * recovery
+	Gq += (1 - Gq) * recovery
+
+	return Gc, Gq, Gq
+}
@@ -0,0 +1,96 @@
+package slope
+
+import (
+	helper "github.com/geoport/GeoGo/bearing_capacity"
+	"github.com/geoport/GeoGo/models"
+)
+
+// CalcBearingCapacity calculates the ultimate bearing capacity of a strip foundation on or near a slope crest using
+// Meyerhof's (1957) charts, Bowles' adaptation of Hansen's method and the stress characteristics solution of
+// Graham et al. (1988).
+//
+// Parameters:
+//
+// - soilProfile (models.SoilProfile): The soil profile to be analyzed.
+//
+// - foundationData (models.Foundation): The foundation data. SlopeAngle, SlopeHeight and SlopeSetback define the
+// slope geometry relative to the foundation.
+//
+// - term (string): Defines the type of analysis (short || long).
+//
+// Returns:
+//
+// - result (Result): The result of the bearing capacity analysis for each method.
+func CalcBearingCapacity(soilProfile models.SoilProfile, foundationData models.Foundation, term string) Result {
+	//unitWeight is in t/m3
+	//cohesion is in t/m2
+	//stress is in t/m2
+	//bearing capacity is in t/m2
+	Df := foundationData.FoundationDepth
+	B := foundationData.FoundationWidth
+	L := foundationData.FoundationLength
+	slopeAngle := foundationData.SlopeAngle
+
+	setbackRatio := foundationData.SlopeSetback / B
+	var heightSetbackRatio float64
+	if foundationData.SlopeHeight > 0 {
+		heightSetbackRatio = foundationData.SlopeSetback / foundationData.SlopeHeight
+	}
+	depthRatio := Df / B
+
+	effectiveUnitWeight := helper.CalcEffectiveUnitWeight(Df, B, soilProfile, term)
+	stress := helper.CalcStress(soilProfile, Df, term)
+	cohesion, phi := helper.GetSoilParams(Df, soilProfile, term)
+
+	var stabilityNumber float64
+	if cohesion > 0 {
+		stabilityNumber = effectiveUnitWeight * foundationData.SlopeHeight / cohesion
+	}
+
+	// Meyerhof
+	Ncq := calcMeyerhofNcq(slopeAngle, setbackRatio, heightSetbackRatio, depthRatio, stabilityNumber)
+	meyerhofNgq := calcMeyerhofNgq(phi, slopeAngle, setbackRatio, depthRatio)
+	meyerhofCapacity := cohesion*Ncq + 0.5*effectiveUnitWeight*B*meyerhofNgq
+
+	// Graham et al.
+	grahamNgq := calcGrahamNgq(phi, slopeAngle, setbackRatio, depthRatio)
+	grahamCapacity := 0.5 * effectiveUnitWeight * B * grahamNgq
+
+	// Bowles
+	Nc, Nq, Ng := calcBearingCapacityFactors(phi, slopeAngle)
+	if phi == 0 {
+		Ng *= 1 - calcSetbackRecovery(setbackRatio, depthRatio)
+	}
+	Sc, Sq, Sg := calcShapeFactors(B, L, Nq, Nc, phi)
+	Dc, Dq, Dg := calcDepthFactors(Df, B, phi)
+	Gc, Gq, Gg := calcGroundFactors(slopeAngle, setbackRatio, depthRatio)
+
+	partC := cohesion * Nc * Sc * Dc * Gc
+	partQ := stress * Nq * Sq * Dq * Gq
+	partG := 0.5 * effectiveUnitWeight * B * Ng * Sg * Dg * Gg
+	bowlesCapacity := partC + partQ + partG
+
+	return Result{
+		Meyerhof: MeyerhofResult{
+			Ncq:                     Ncq,
+			Ngq:                     meyerhofNgq,
+			UltimateBearingCapacity: meyerhofCapacity,
+		},
+		Graham: GrahamResult{
+			Ngq:                     grahamNgq,
+			UltimateBearingCapacity: grahamCapacity,
+		},
+		Bowles: BowlesResult{
+			BearingCapacityFactors:  BearingCapacityFactors{Nc: Nc, Nq: Nq, Ng: Ng},
+			ShapeFactors:            ShapeFactors{Sc: Sc, Sq: Sq, Sg: Sg},
+			DepthFactors:            DepthFactors{Dc: Dc, Dq: Dq, Dg: Dg},
+			GroundFactors:           GroundFactors{Gc: Gc, Gq: Gq, Gg: Gg},
+			UltimateBearingCapacity: bowlesCapacity,
+		},
+		SoilParams:         BCSoilParams{UnitWeight: effectiveUnitWeight, Cohesion: cohesion, FrictionAngle: phi},
+		SetbackRatio:       setbackRatio,
+		HeightSetbackRatio: heightSetbackRatio,
+		DepthRatio:         depthRatio,
+		StabilityNumber:    stabilityNumber,
+	}
+}
@@ -0,0 +1,102 @@
+package slope
+
+import (
+	"testing"
+
+	dt "github.com/geoport/GeoGo/data"
+	pkg "github.com/geoport/GeoGo/internal"
+)
+
+func TestCalcMeyerhofNgq(t *testing.T) {
+	// On level ground the setback has no effect
+	level := calcMeyerhofNgq(35, 0, 0, 0)
+	expectedLevel := 50.
+	if !pkg.AssertFloat(level, expectedLevel, 0.01) {
+		t.Errorf("Got %v, want %v for level Ngq", level, expectedLevel)
+	}
+
+	onCrest := calcMeyerhofNgq(40, 20, 0, 0)
+	farAway := calcMeyerhofNgq(40, 20, 10, 0)
+	if !pkg.AssertFloat(onCrest, 48, 0.01) {
+		t.Errorf("Got %v, want %v for Ngq on crest", onCrest, 48)
+	}
+	if !pkg.AssertFloat(farAway, 100, 0.01) {
+		t.Errorf("Got %v, want %v for Ngq far from crest", farAway, 100)
+	}
+}
+
+func TestCalcMeyerhofNcq(t *testing.T) {
+	expected := 3.5
+	Ncq := calcMeyerhofNcq(60, 0, 0, 0, 0)
+	if !pkg.AssertFloat(Ncq, expected, 0.01) {
+		t.Errorf("Got %v, want %v for Ncq", Ncq, expected)
+	}
+
+	expected = 2.9
+	Ncq = calcMeyerhofNcq(60, 2, 2, 0, 4)
+	if !pkg.AssertFloat(Ncq, expected, 0.01) {
+		t.Errorf("Got %v, want %v for Ncq", Ncq, expected)
+	}
+
+	// B = 2 m, H = 6 m and b = 6 m: the Ns = 2 chart is read at b/H = 1, not at b/B = 3
+	expected = 3.1
+	Ncq = calcMeyerhofNcq(60, 3, 1, 0, 2)
+	if !pkg.AssertFloat(Ncq, expected, 0.01) {
+		t.Errorf("Got %v, want %v for Ncq", Ncq, expected)
+	}
+}
+
+func TestCalcGrahamNgq(t *testing.T) {
+	expected := 62.
+	Ngq := calcGrahamNgq(40, 20, 1, 0)
+	if !pkg.AssertFloat(Ngq, expected, 0.01) {
+		t.Errorf("Got %v, want %v for Ngq", Ngq, expected)
+	}
+}
+
+func TestCalcGroundFactors(t *testing.T) {
+	expectedGc := 0.86
+	expectedGq := 0.37
+	Gc, Gq, _ := calcGroundFactors(20, 0, 0)
+	if !pkg.AssertFloat(Gc, expectedGc, 0.01) {
+		t.Errorf("Got %v, want %v for Gc", Gc, expectedGc)
+	}
+	if !pkg.AssertFloat(Gq, expectedGq, 0.01) {
+		t.Errorf("Got %v, want %v for Gq", Gq, expectedGq)
+	}
+
+	Gc, Gq, _ = calcGroundFactors(20, 6, 0)
+	if Gc != 1 || Gq != 1 {
+		t.Errorf("Got %v and %v, want 1 for ground factors beyond the setback limit", Gc, Gq)
+	}
+}
+
+func TestCalcBearingCapacity(t *testing.T) {
+	soilProfile := dt.SoilProfile.Copy()
+	soilProfile.CalcLayerDepths()
+	foundationData := dt.FoundationData
+	foundationData.FoundationWidth = 2
+	foundationData.SlopeAngle = 30
+	foundationData.SlopeHeight = 6
+
+	nearCrest := CalcBearingCapacity(soilProfile, foundationData, "long")
+	foundationData.SlopeSetback = 4
+	setBack := CalcBearingCapacity(soilProfile, foundationData, "long")
+
+	expected := 13.94
+	if !pkg.AssertFloat(nearCrest.Meyerhof.UltimateBearingCapacity, expected, 0.01) {
+		t.Errorf("Got %v, want %v", nearCrest.Meyerhof.UltimateBearingCapacity, expected)
+	}
+	if !pkg.AssertFloat(setBack.HeightSetbackRatio, 4./6, 1e-9) {
+		t.Errorf("Got %v, want %v for b/H", setBack.HeightSetbackRatio, 4./6)
+	}
+	if setBack.Meyerhof.UltimateBearingCapacity <= nearCrest.Meyerhof.UltimateBearingCapacity {
+		t.Errorf("Expected Meyerhof capacity to increase with setback")
+	}
+	if setBack.Bowles.UltimateBearingCapacity <= nearCrest.Bowles.UltimateBearingCapacity {
+		t.Errorf("Expected Bowles capacity to increase with setback")
+	}
+	if setBack.Graham.UltimateBearingCapacity <= nearCrest.Graham.UltimateBearingCapacity {
+		t.Errorf("Expected Graham capacity to increase with setback")
+	}
+}
@@ -0,0 +1,61 @@
+package slope
+
+type Result struct {
+	Meyerhof           MeyerhofResult `json:"meyerhof"`
+	Bowles             BowlesResult   `json:"bowles"`
+	Graham             GrahamResult   `json:"graham"`
+	SoilParams         BCSoilParams   `json:"soilParams"`
+	SetbackRatio       float64        `json:"setbackRatio"`       // b/B
+	HeightSetbackRatio float64        `json:"heightSetbackRatio"` // b/H
+	DepthRatio         float64        `json:"depthRatio"`
+	StabilityNumber    float64        `json:"stabilityNumber"`
+}
+
+type BCSoilParams struct {
+	UnitWeight    float64 `json:"unitWeight"`
+	Cohesion      float64 `json:"cohesion"`
+	FrictionAngle float64 `json:"frictionAngle"`
+}
+
+type MeyerhofResult struct {
+	Ncq                     float64 `json:"Ncq"`
+	Ngq                     float64 `json:"Ngq"`
+	UltimateBearingCapacity float64 `json:"ultimateBearingCapacity"`
+}
+
+type GrahamResult struct {
+	Ngq                     float64 `json:"Ngq"`
+	UltimateBearingCapacity float64 `json:"ultimateBearingCapacity"`
+}
+
+type BowlesResult struct {
+	BearingCapacityFactors  BearingCapacityFactors `json:"bearingCapacityFactors"`
+	ShapeFactors            ShapeFactors           `json:"shapeFactors"`
+	DepthFactors            DepthFactors           `json:"depthFactors"`
+	GroundFactors           GroundFactors          `json:"groundFactors"`
+	UltimateBearingCapacity float64                `json:"ultimateBearingCapacity"`
+}
+
+type BearingCapacityFactors struct {
+	Nq float64 `json:"Nq"`
+	Nc float64 `json:"Nc"`
+	Ng float64 `json:"Ng"`
+}
+
+type ShapeFactors struct {
+	Sq float64 `json:"Sq"`
+	Sc float64 `json:"Sc"`
+	Sg float64 `json:"Sg"`
+}
+
+type DepthFactors struct {
+	Dq float64 `json:"Dq"`
+	Dc float64 `json:"Dc"`
+	Dg float64 `json:"Dg"`
+}
+
+type GroundFactors struct {
+	Gq float64 `json:"Gq"`
+	Gc float64 `json:"Gc"`
+	Gg float64 `json:"Gg"`
+}
@@ -79,10 +79,15 @@ func CalcBearingCapacity(soilProfile models.SoilProfile, foundationData models.F
 		sg = 1
 	}
 
-	ultimateBearingCapacity := cohesion*Nc*sc + stress*Nq + 0.5*effectiveUnitWeight*B_*Ng*sg
+	partC := cohesion * Nc * sc
+	partQ := stress * Nq
+	partG := 0.5 * effectiveUnitWeight * B_ * Ng * sg
+
+	ultimateBearingCapacity := partC + partQ + partG
 
 	return Result{
 		UltimateBearingCapacity: ultimateBearingCapacity,
+		CapacityTerms:           CapacityTerms{C: partC, Q: partQ, G: partG},
 		BearingCapacityFactors: BearingCapacityFactors{
 			Nc: Nc,
 			Nq: Nq,
@@ -3,6 +3,7 @@ package terzaghi
 type Result struct {
 	BearingCapacityFactors  BearingCapacityFactors `json:"bearingCapacityFactors"`
 	SoilParams              BCSoilParams           `json:"soilParams"`
+	CapacityTerms           CapacityTerms          `json:"capacityTerms"`
 	UltimateBearingCapacity float64                `json:"ultimateBearingCapacity"`
 }
 
@@ -17,3 +18,10 @@ type BearingCapacityFactors struct {
 	Nc float64 `json:"Nc"`
 	Ng float64 `json:"Ng"`
 }
+
+// CapacityTerms holds the cohesion (C), surcharge (Q) and unit weight (G) terms of the ultimate bearing capacity.
+type CapacityTerms struct {
+	C float64 `json:"C"`
+	Q float64 `json:"Q"`
+	G float64 `json:"G"`
+}
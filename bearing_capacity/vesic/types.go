@@ -8,6 +8,7 @@ type Result struct {
 	GroundFactors           GroundFactors          `json:"groundFactors"`
 	BaseFactors             BaseFactors            `json:"baseFactors"`
 	SoilParams              BCSoilParams           `json:"soilParams"`
+	CapacityTerms           CapacityTerms          `json:"capacityTerms"`
 	UltimateBearingCapacity float64                `json:"ultimateBearingCapacity"`
 }
 
@@ -52,3 +53,10 @@ type BaseFactors struct {
 	Bc float64 `json:"Bc"`
 	Bg float64 `json:"Bg"`
 }
+
+// CapacityTerms holds the cohesion (C), surcharge (Q) and unit weight (G) terms of the ultimate bearing capacity.
+type CapacityTerms struct {
+	C float64 `json:"C"`
+	Q float64 `json:"Q"`
+	G float64 `json:"G"`
+}
@@ -57,6 +57,7 @@ func CalcBearingCapacity(
 
 	result := Result{
 		UltimateBearingCapacity: ultimateBearingCapacity,
+		CapacityTerms:           CapacityTerms{C: partC, Q: partQ, G: partG},
 		BearingCapacityFactors: BearingCapacityFactors{
 			Nc: Nc,
 			Nq: Nq,
@@ -4,7 +4,94 @@ import "github.com/geoport/GeoGo/models"
 
 var Pressuremeter = models.Pressuremeter{
 	Exps: []models.PressuremeterExp{
-		{Depth: 3, LimitPressure: 47.5, NetLimitPressure: 42.5},
-		{Depth: 3.5, LimitPressure: 48.2, NetLimitPressure: 43.3},
+		{Depth: 3, LimitPressure: 47.5, NetLimitPressure: 42.5, EM: 520},
+		{Depth: 3.5, LimitPressure: 48.2, NetLimitPressure: 43.3, EM: 545},
+	},
+}
+
+var SPT = models.SPT{
+	Exps: []models.SptExp{
+		{Depth: 1.5, N: 8, N60: 7},
+		{Depth: 3, N: 12, N60: 10},
+		{Depth: 4.5, N: 15, N60: 13},
+		{Depth: 6, N: 18, N60: 16},
+		{Depth: 7.5, N: 22, N60: 19},
+		{Depth: 9, N: 25, N60: 22},
+		{Depth: 10.5, N: 27, N60: 24},
+	},
+}
+
+var CPT = models.CPT{
+	Exps: []models.CptExp{
+		{Depth: 0.5, ConeResistance: 280, PorePressure: 0},
+		{Depth: 1, ConeResistance: 310, PorePressure: 0},
+		{Depth: 1.5, ConeResistance: 380, PorePressure: 0},
+		{Depth: 2, ConeResistance: 370, PorePressure: 0},
+		{Depth: 2.5, ConeResistance: 400, PorePressure: 0},
+		{Depth: 3, ConeResistance: 470, PorePressure: 0},
+		{Depth: 3.5, ConeResistance: 460, PorePressure: 0},
+		{Depth: 4, ConeResistance: 490, PorePressure: 0},
+		{Depth: 4.5, ConeResistance: 560, PorePressure: 0},
+		{Depth: 5, ConeResistance: 550, PorePressure: 0},
+		{Depth: 5.5, ConeResistance: 580, PorePressure: 0.5},
+		{Depth: 6, ConeResistance: 650, PorePressure: 1},
+		{Depth: 6.5, ConeResistance: 640, PorePressure: 1.5},
+		{Depth: 7, ConeResistance: 670, PorePressure: 2},
+		{Depth: 7.5, ConeResistance: 740, PorePressure: 2.5},
+		{Depth: 8, ConeResistance: 730, PorePressure: 3},
+		{Depth: 8.5, ConeResistance: 760, PorePressure: 3.5},
+		{Depth: 9, ConeResistance: 830, PorePressure: 4},
+		{Depth: 9.5, ConeResistance: 820, PorePressure: 4.5},
+		{Depth: 10, ConeResistance: 850, PorePressure: 5},
+		{Depth: 10.5, ConeResistance: 920, PorePressure: 5.5},
+		{Depth: 11, ConeResistance: 910, PorePressure: 6},
+		{Depth: 11.5, ConeResistance: 940, PorePressure: 6.5},
+		{Depth: 12, ConeResistance: 1010, PorePressure: 7},
+	},
+}
+
+var PressuremeterRawTest = models.PressuremeterRawTest{
+	Depth:       4,
+	ProbeVolume: 535,
+	GaugeHeight: 1,
+	Readings: []models.PressuremeterReading{
+		{Pressure: 5, Volume: 60},
+		{Pressure: 10, Volume: 110},
+		{Pressure: 15, Volume: 135},
+		{Pressure: 20, Volume: 150},
+		{Pressure: 25, Volume: 165},
+		{Pressure: 30, Volume: 180},
+		{Pressure: 35, Volume: 200},
+		{Pressure: 40, Volume: 235},
+		{Pressure: 45, Volume: 290},
+		{Pressure: 50, Volume: 380},
+		{Pressure: 55, Volume: 520},
+	},
+	MembraneCalibration: []models.PressuremeterReading{
+		{Pressure: 0.5, Volume: 50},
+		{Pressure: 1, Volume: 200},
+		{Pressure: 1.5, Volume: 400},
+		{Pressure: 2, Volume: 600},
+		{Pressure: 2.5, Volume: 800},
+	},
+	VolumeCalibration: []models.PressuremeterReading{
+		{Pressure: 0, Volume: 0},
+		{Pressure: 20, Volume: 4},
+		{Pressure: 40, Volume: 8},
+		{Pressure: 60, Volume: 12},
+	},
+}
+
+var PlateLoadTest = models.PlateLoadTest{
+	PlateWidth: 0.3,
+	Readings: []models.PlateLoadReading{
+		{Pressure: 0, Settlement: 0},
+		{Pressure: 5, Settlement: 0.08},
+		{Pressure: 10, Settlement: 0.18},
+		{Pressure: 15, Settlement: 0.32},
+		{Pressure: 20, Settlement: 0.52},
+		{Pressure: 25, Settlement: 0.85},
+		{Pressure: 30, Settlement: 1.45},
+		{Pressure: 35, Settlement: 3.4},
 	},
 }
@@ -0,0 +1,20 @@
+package data
+
+import "github.com/geoport/GeoGo/models"
+
+var MachineData = models.Machine{
+	Weight:          20,
+	Height:          1,
+	OperatingSpeed:  1500,
+	VerticalForce:   0.5,
+	HorizontalForce: 0.5,
+	ForceHeight:     2.8,
+}
+
+var MachineBlockData = models.MachineBlock{
+	Width:      4,
+	Length:     6,
+	Height:     2,
+	Depth:      1.5,
+	UnitWeight: 2.5,
+}
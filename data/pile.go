@@ -0,0 +1,33 @@
+package data
+
+import "github.com/geoport/GeoGo/models"
+
+var PileData = models.Pile{
+	Diameter:       0.8,
+	Length:         15,
+	Type:           "bored",
+	Material:       "concrete",
+	ElasticModulus: 3000000,
+	YieldMoment:    60,
+}
+
+var PileGroupData = models.PileGroup{
+	Rows:    3,
+	Columns: 4,
+	Spacing: 2.4,
+}
+
+var PileLoadTestData = models.PileLoadTest{
+	Readings: []models.PileLoadReading{
+		{Load: 0, Settlement: 0},
+		{Load: 20, Settlement: 0.03},
+		{Load: 40, Settlement: 0.08},
+		{Load: 60, Settlement: 0.13},
+		{Load: 80, Settlement: 0.2},
+		{Load: 100, Settlement: 0.3},
+		{Load: 120, Settlement: 0.45},
+		{Load: 140, Settlement: 0.7},
+		{Load: 160, Settlement: 1.2},
+		{Load: 180, Settlement: 2.7},
+	},
+}
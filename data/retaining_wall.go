@@ -0,0 +1,10 @@
+package data
+
+import "github.com/geoport/GeoGo/models"
+
+var RetainingWallData = models.RetainingWall{
+	Height:        7,
+	WallFriction:  15,
+	WallAngle:     0,
+	BackfillSlope: 0,
+}
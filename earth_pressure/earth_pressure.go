@@ -0,0 +1,280 @@
+package earth_pressure
+
+import (
+	"math"
+	"sort"
+
+	pkg "github.com/geoport/GeoGo/internal"
+	"github.com/geoport/GeoGo/models"
+)
+
+// unit weight of water in t/m3, consistent with models.SoilProfile
+const waterUnitWeight = 0.981
+
+// getStrength returns the drained cohesion and friction angle of the layer.
+func getStrength(layer models.SoilLayer) (float64, float64) {
+	phi := layer.EffectiveFrictionAngle
+	if phi == 0 {
+		phi = layer.FrictionAngle
+	}
+	return layer.Cohesion, phi
+}
+
+// CalcJakyCoefficient returns the at-rest earth pressure coefficient K0 = (1 - sinφ)·OCR^sinφ of Jaky (1944) with the
+// overconsolidation correction of Mayne & Kulhawy (1982).
+//
+// Parameters:
+//
+// - phi (float64): Effective friction angle (in degrees).
+//
+// - OCR (float64): Overconsolidation ratio. Values below 1 are taken as 1.
+//
+// Returns:
+//
+// - K0 (float64)
+func CalcJakyCoefficient(phi, OCR float64) float64 {
+	sinPhi := math.Sin(pkg.Radian(phi))
+	return (1 - sinPhi) * math.Pow(math.Max(OCR, 1), sinPhi)
+}
+
+// CalcRankineCoefficients returns the Rankine active and passive earth pressure coefficients for a backfill sloping
+// at angle i. The coefficients reduce to tan²(45 ∓ φ/2) for a horizontal backfill.
+//
+// Parameters:
+//
+// - phi (float64): Friction angle (in degrees).
+//
+// - i (float64): Backfill slope (in degrees).
+//
+// Returns:
+//
+// - Ka (float64)
+//
+// - Kp (float64)
+func CalcRankineCoefficients(phi, i float64) (float64, float64) {
+	cosI := math.Cos(pkg.Radian(i))
+	cosPhi := math.Cos(pkg.Radian(phi))
+	root := math.Sqrt(math.Max(cosI*cosI-cosPhi*cosPhi, 0))
+
+	Ka := cosI * (cosI - root) / (cosI + root)
+	Kp := cosI * (cosI + root) / (cosI - root)
+	return Ka, Kp
+}
+
+// CalcCoulombCoefficients returns the Coulomb active and passive earth pressure coefficients.
+//
+// Parameters:
+//
+// - phi (float64): Friction angle (in degrees).
+//
+// - delta (float64): Wall friction angle (in degrees).
+//
+// - theta (float64): Inclination of the back face of the wall from the vertical (in degrees).
+//
+// - i (float64): Backfill slope (in degrees).
+//
+// Returns:
+//
+// - Ka (float64)
+//
+// - Kp (float64)
+func CalcCoulombCoefficients(phi, delta, theta, i float64) (float64, float64) {
+	phi, delta, theta, i = pkg.Radian(phi), pkg.Radian(delta), pkg.Radian(theta), pkg.Radian(i)
+	cosTheta2 := math.Pow(math.Cos(theta), 2)
+
+	activeRoot := math.Sqrt(
+		math.Max(math.Sin(phi+delta)*math.Sin(phi-i)/(math.Cos(delta+theta)*math.Cos(theta-i)), 0),
+	)
+	Ka := math.Pow(math.Cos(phi-theta), 2) / (cosTheta2 * math.Cos(delta+theta) * math.Pow(1+activeRoot, 2))
+
+	passiveRoot := math.Sqrt(
+		math.Max(math.Sin(phi+delta)*math.Sin(phi+i)/(math.Cos(delta-theta)*math.Cos(i-theta)), 0),
+	)
+	Kp := math.Pow(math.Cos(phi+theta), 2) / (cosTheta2 * math.Cos(delta-theta) * math.Pow(1-passiveRoot, 2))
+	return Ka, Kp
+}
+
+// getBreakpoints returns the depths where the pressure diagram changes slope: the surface, the layer boundaries, the
+// groundwater table and the base of the wall.
+func getBreakpoints(soilProfile models.SoilProfile, H float64) []float64 {
+	depths := []float64{0, H}
+	for _, layer := range soilProfile.Layers {
+		if layer.Depth > 0 && layer.Depth < H {
+			depths = append(depths, layer.Depth)
+		}
+	}
+	if soilProfile.Gwt > 0 && soilProfile.Gwt < H {
+		depths = append(depths, soilProfile.Gwt)
+	}
+	sort.Float64s(depths)
+
+	var unique []float64
+	for _, depth := range depths {
+		if len(unique) == 0 || depth > unique[len(unique)-1] {
+			unique = append(unique, depth)
+		}
+	}
+	return unique
+}
+
+// calcWaterPressure returns the hydrostatic pressure at the given depth.
+func calcWaterPressure(soilProfile models.SoilProfile, z float64) float64 {
+	return math.Max(z-soilProfile.Gwt, 0) * waterUnitWeight
+}
+
+// integrateSegment returns the force and its moment about the base of the wall for a linear pressure distribution
+// between z1 and z2. Negative pressures (tension) are ignored.
+func integrateSegment(z1, z2, p1, p2, H float64) (float64, float64) {
+	if p1 <= 0 && p2 <= 0 {
+		return 0, 0
+	}
+	if p1 < 0 {
+		z1 = z1 + (z2-z1)*(-p1)/(p2-p1)
+		p1 = 0
+	} else if p2 < 0 {
+		z2 = z1 + (z2-z1)*p1/(p1-p2)
+		p2 = 0
+	}
+	h := z2 - z1
+	force := (p1 + p2) / 2 * h
+	centroid := z1 + h*(p1+2*p2)/(3*(p1+p2))
+	return force, force * (H - centroid)
+}
+
+// calcPressureDiagram returns the pressure diagram of a state. The coefficient of each layer is given by getK and
+// the cohesion term is -2c√K for active, +2c√K for passive and neglected for at-rest states.
+//
+// Parameters:
+//
+// - soilProfile (models.SoilProfile): The soil profile.
+//
+// - H (float64): Height of the wall (in meters).
+//
+// - surcharge (float64): Uniform surcharge on the backfill (in t/m2).
+//
+// - getK (func(layerIndex int) float64): Earth pressure coefficient of the layer.
+//
+// - cohesionSign (float64): -1 for active, +1 for passive and 0 for at-rest states.
+//
+// - inclination (float64): Angle between the resultant and the horizontal (in degrees).
+//
+// Returns:
+//
+// - result (PressureResult)
+func calcPressureDiagram(
+	soilProfile models.SoilProfile, H, surcharge float64, getK func(layerIndex int) float64, cohesionSign,
+	inclination float64,
+) PressureResult {
+	var result PressureResult
+	for i := 0; i <= soilProfile.GetLayerIndex(H); i++ {
+		result.Coefficients = append(result.Coefficients, getK(i))
+	}
+
+	var moment, totalForce, totalMoment float64
+	breakpoints := getBreakpoints(soilProfile, H)
+	for j := 1; j < len(breakpoints); j++ {
+		z1, z2 := breakpoints[j-1], breakpoints[j]
+		layerIndex := soilProfile.GetLayerIndex((z1 + z2) / 2)
+		c, _ := getStrength(soilProfile.Layers[layerIndex])
+		K := result.Coefficients[layerIndex]
+		cohesionTerm := cohesionSign * 2 * c * math.Sqrt(K)
+
+		p1 := K*(soilProfile.CalcEffectiveStress(z1)+surcharge) + cohesionTerm
+		p2 := K*(soilProfile.CalcEffectiveStress(z2)+surcharge) + cohesionTerm
+		u1, u2 := calcWaterPressure(soilProfile, z1), calcWaterPressure(soilProfile, z2)
+
+		force, segmentMoment := integrateSegment(z1, z2, p1, p2, H)
+		result.Force += force
+		moment += segmentMoment
+
+		horizontalForce := force * math.Cos(pkg.Radian(inclination))
+		waterForce, waterMoment := integrateSegment(z1, z2, u1, u2, H)
+		totalForce += horizontalForce + waterForce
+		totalMoment += segmentMoment*math.Cos(pkg.Radian(inclination)) + waterMoment
+
+		result.Depths = append(result.Depths, z1, z2)
+		result.Pressures = append(result.Pressures, math.Max(p1, 0), math.Max(p2, 0))
+		result.TotalPressures = append(result.TotalPressures, math.Max(p1, 0)+u1, math.Max(p2, 0)+u2)
+	}
+
+	result.HorizontalForce = result.Force * math.Cos(pkg.Radian(inclination))
+	result.VerticalForce = result.Force * math.Sin(pkg.Radian(inclination))
+	result.TotalHorizontalForce = totalForce
+	if result.Force > 0 {
+		result.PointOfApplication = moment / result.Force
+	}
+	if totalForce > 0 {
+		result.TotalPointOfApplication = totalMoment / totalForce
+	}
+	return result
+}
+
+// CalcEarthPressure calculates the at-rest, Rankine and Coulomb earth pressure diagrams on a retaining wall over a
+// layered soil profile with drained strength parameters. Tension in the active zone is neglected. The at-rest
+// coefficient of each layer uses the overconsolidation ratio at the middle of the layer within the wall height,
+// obtained from the preconsolidation pressure when given.
+//
+// Parameters:
+//
+// - soilProfile (models.SoilProfile): The soil profile behind the wall.
+//
+// - wall (models.RetainingWall): The retaining wall.
+//
+// - surcharge (float64): Uniform surcharge on the backfill (in t/m2).
+//
+// Returns:
+//
+// - result (Result)
+func CalcEarthPressure(soilProfile models.SoilProfile, wall models.RetainingWall, surcharge float64) Result {
+	H := wall.Height
+	delta, theta, i := wall.WallFriction, wall.WallAngle, wall.BackfillSlope
+
+	getOCR := func(layerIndex int) float64 {
+		layer := soilProfile.Layers[layerIndex]
+		top := layer.Depth - layer.Thickness
+		z := (top + math.Min(layer.Depth, H)) / 2
+		stress := soilProfile.CalcEffectiveStress(z)
+		if layer.PreconsolidationPressure == 0 || stress == 0 {
+			return 1
+		}
+		return layer.PreconsolidationPressure / stress
+	}
+	getPhi := func(layerIndex int) float64 {
+		_, phi := getStrength(soilProfile.Layers[layerIndex])
+		return phi
+	}
+
+	atRest := func(layerIndex int) float64 {
+		return CalcJakyCoefficient(getPhi(layerIndex), getOCR(layerIndex))
+	}
+	rankineActive := func(layerIndex int) float64 {
+		Ka, _ := CalcRankineCoefficients(getPhi(layerIndex), i)
+		return Ka
+	}
+	rankinePassive := func(layerIndex int) float64 {
+		_, Kp := CalcRankineCoefficients(getPhi(layerIndex), i)
+		return Kp
+	}
+	coulombActive := func(layerIndex int) float64 {
+		Ka, _ := CalcCoulombCoefficients(getPhi(layerIndex), delta, theta, i)
+		return Ka
+	}
+	coulombPassive := func(layerIndex int) float64 {
+		_, Kp := CalcCoulombCoefficients(getPhi(layerIndex), delta, theta, i)
+		return Kp
+	}
+
+	waterHeight := math.Max(H-math.Max(soilProfile.Gwt, 0), 0)
+
+	return Result{
+		AtRest:         calcPressureDiagram(soilProfile, H, surcharge, atRest, 0, 0),
+		RankineActive:  calcPressureDiagram(soilProfile, H, surcharge, rankineActive, -1, i),
+		RankinePassive: calcPressureDiagram(soilProfile, H, surcharge, rankinePassive, 1, i),
+		CoulombActive:  calcPressureDiagram(soilProfile, H, surcharge, coulombActive, -1, delta+theta),
+		CoulombPassive: calcPressureDiagram(soilProfile, H, surcharge, coulombPassive, 1, theta-delta),
+		Water: WaterResult{
+			Force:              waterUnitWeight * waterHeight * waterHeight / 2,
+			PointOfApplication: waterHeight / 3,
+		},
+	}
+}
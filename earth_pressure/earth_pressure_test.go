@@ -0,0 +1,59 @@
+package earth_pressure
+
+import (
+	"testing"
+
+	dt "github.com/geoport/GeoGo/data"
+	pkg "github.com/geoport/GeoGo/internal"
+)
+
+func TestCoefficients(t *testing.T) {
+	Ka, Kp := CalcRankineCoefficients(30, 0)
+	if !pkg.AssertFloatArray([]float64{Ka, Kp}, []float64{0.3333, 3}, 0.0001) {
+		t.Errorf("Got %v and %v, want %v and %v for Rankine", Ka, Kp, 0.3333, 3)
+	}
+
+	Ka, Kp = CalcCoulombCoefficients(30, 20, 0, 0)
+	if !pkg.AssertFloatArray([]float64{Ka, Kp}, []float64{0.2973, 6.1054}, 0.0001) {
+		t.Errorf("Got %v and %v, want %v and %v for Coulomb", Ka, Kp, 0.2973, 6.1054)
+	}
+
+	K0 := CalcJakyCoefficient(30, 4)
+	if !pkg.AssertFloat(K0, 1, 0.0001) {
+		t.Errorf("Got %v, want %v for Jaky", K0, 1)
+	}
+}
+
+func TestCalcEarthPressure(t *testing.T) {
+	soilProfile := dt.SoilProfile.Copy()
+	soilProfile.CalcLayerDepths()
+
+	output := CalcEarthPressure(soilProfile, dt.RetainingWallData, 2)
+
+	expectedDepths := []float64{0, 3, 3, 5, 5, 7}
+	if !pkg.AssertFloatArray(output.RankineActive.Depths, expectedDepths, 0.001) {
+		t.Errorf("Got %v, want %v for depths", output.RankineActive.Depths, expectedDepths)
+	}
+	expected := []float64{0, 2.121, 2.071, 3.443, 3.443, 4.179}
+	if !pkg.AssertFloatArray(output.RankineActive.Pressures, expected, 0.001) {
+		t.Errorf("Got %v, want %v for Rankine active pressures", output.RankineActive.Pressures, expected)
+	}
+
+	values := []float64{
+		output.AtRest.Force, output.RankineActive.Force, output.RankinePassive.Force, output.CoulombActive.HorizontalForce,
+		output.CoulombPassive.HorizontalForce,
+	}
+	expected = []float64{31.88, 15.78, 164.45, 13.40, 248.17}
+	if !pkg.AssertFloatArray(values, expected, 0.01) {
+		t.Errorf("Got %v, want %v for forces", values, expected)
+	}
+
+	values = []float64{
+		output.RankineActive.PointOfApplication, output.RankineActive.TotalHorizontalForce,
+		output.RankineActive.TotalPointOfApplication, output.CoulombActive.VerticalForce, output.Water.Force,
+	}
+	expected = []float64{2.297, 17.742, 2.116, 3.591, 1.962}
+	if !pkg.AssertFloatArray(values, expected, 0.001) {
+		t.Errorf("Got %v, want %v for resultants", values, expected)
+	}
+}
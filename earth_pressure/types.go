@@ -0,0 +1,31 @@
+package earth_pressure
+
+type Result struct {
+	AtRest         PressureResult `json:"atRest"`
+	RankineActive  PressureResult `json:"rankineActive"`
+	RankinePassive PressureResult `json:"rankinePassive"`
+	CoulombActive  PressureResult `json:"coulombActive"`
+	CoulombPassive PressureResult `json:"coulombPassive"`
+	Water          WaterResult    `json:"water"`
+}
+
+// PressureResult holds the pressure diagram of an earth pressure state. The diagram has two points at each layer
+// boundary, one for the layer above and one for the layer below. Forces are per unit length of the wall and points
+// of application are measured upwards from the base of the wall.
+type PressureResult struct {
+	Coefficients            []float64 `json:"coefficients"`            // one per layer within the wall height
+	Depths                  []float64 `json:"depths"`                  // meter
+	Pressures               []float64 `json:"pressures"`               // t/m2, effective earth pressure
+	TotalPressures          []float64 `json:"totalPressures"`          // t/m2, earth and water pressure
+	Force                   float64   `json:"force"`                   // t/m, earth pressure resultant
+	HorizontalForce         float64   `json:"horizontalForce"`         // t/m
+	VerticalForce           float64   `json:"verticalForce"`           // t/m
+	PointOfApplication      float64   `json:"pointOfApplication"`      // meter, earth pressure resultant
+	TotalHorizontalForce    float64   `json:"totalHorizontalForce"`    // t/m, horizontal earth and water force
+	TotalPointOfApplication float64   `json:"totalPointOfApplication"` // meter, horizontal earth and water resultant
+}
+
+type WaterResult struct {
+	Force              float64 `json:"force"`              // t/m
+	PointOfApplication float64 `json:"pointOfApplication"` // meter
+}
@@ -0,0 +1,213 @@
+package impedance
+
+import (
+	"math"
+
+	pkg "github.com/geoport/GeoGo/internal"
+	"github.com/geoport/GeoGo/models"
+)
+
+// gravitational acceleration in m/s2
+const g = 9.81
+
+// Strain compatible shear modulus and damping ratios of Eurocode 8 Part 5 Table 4.1 for the effective ground
+// acceleration (in g).
+var (
+	reductionAcceleration = []float64{0, 0.1, 0.2, 0.3}
+	reductionModulus      = []float64{1, 0.8, 0.5, 0.36}
+	reductionDamping      = []float64{0, 0.03, 0.06, 0.1}
+)
+
+// geometry holds the base properties of the foundation. B and L are the half width and half length.
+type geometry struct {
+	B, L, Ab, Ibx, Iby, J float64
+	isRound               bool
+}
+
+func getGeometry(foundationData models.Foundation) geometry {
+	if foundationData.FoundationType == "round" {
+		R := foundationData.FoundationWidth / 2
+		return geometry{
+			B: R, L: R, Ab: math.Pi * R * R,
+			Ibx: math.Pi * math.Pow(R, 4) / 4, Iby: math.Pi * math.Pow(R, 4) / 4, J: math.Pi * math.Pow(R, 4) / 2,
+			isRound: true,
+		}
+	}
+	B := foundationData.FoundationWidth / 2
+	L := math.Max(foundationData.FoundationLength/2, B)
+	Ibx := 4 * L * math.Pow(B, 3) / 3
+	Iby := 4 * B * math.Pow(L, 3) / 3
+	return geometry{B: B, L: L, Ab: 4 * B * L, Ibx: Ibx, Iby: Iby, J: Ibx + Iby}
+}
+
+// calcStaticStiffness returns the static stiffnesses of a surface foundation on a homogeneous half space in the
+// order z, x, y, rx, ry and t by Gazetas (1991). Circular foundations use the exact solutions.
+func calcStaticStiffness(geo geometry, G, nu float64) [6]float64 {
+	if geo.isRound {
+		R := geo.B
+		Kh := 8 * G * R / (2 - nu)
+		Kr := 8 * G * math.Pow(R, 3) / (3 * (1 - nu))
+		return [6]float64{4 * G * R / (1 - nu), Kh, Kh, Kr, Kr, 16 * G * math.Pow(R, 3) / 3}
+	}
+
+	B, L := geo.B, geo.L
+	chi := geo.Ab / (4 * L * L)
+	Ky := 2 * G * L / (2 - nu) * (2 + 2.5*math.Pow(chi, 0.85))
+	return [6]float64{
+		2 * G * L / (1 - nu) * (0.73 + 1.54*math.Pow(chi, 0.75)),
+		Ky - 0.2/(0.75-nu)*G*L*(1-B/L),
+		Ky,
+		G / (1 - nu) * math.Pow(geo.Ibx, 0.75) * math.Pow(L/B, 0.25) * (2.4 + 0.5*B/L),
+		3 * G / (1 - nu) * math.Pow(geo.Iby, 0.75) * math.Pow(L/B, 0.15),
+		G * math.Pow(geo.J, 0.75) * (4 + 11*math.Pow(1-B/L, 10)),
+	}
+}
+
+// calcEmbedmentFactors returns the stiffness increase factors of Gazetas (1991) for a foundation at depth D with full
+// contact on the side walls.
+func calcEmbedmentFactors(geo geometry, D float64) [6]float64 {
+	if D <= 0 {
+		return [6]float64{1, 1, 1, 1, 1, 1}
+	}
+	B, L := geo.B, geo.L
+	d := D
+	chi := geo.Ab / (4 * L * L)
+	Aw := 4 * d * (B + L)
+	if geo.isRound {
+		Aw = 2 * math.Pi * B * d
+	}
+	h := D - d/2
+
+	Kh := (1 + 0.15*math.Sqrt(D/B)) * (1 + 0.52*math.Pow(h*Aw/(B*L*L), 0.4))
+	return [6]float64{
+		(1 + D/(21*B)*(1+1.3*chi)) * (1 + 0.2*math.Pow(Aw/geo.Ab, 2./3)),
+		Kh,
+		Kh,
+		1 + 1.26*d/B*(1+d/B*math.Pow(d/D, -0.2)*math.Sqrt(B/L)),
+		1 + 0.92*math.Pow(d/L, 0.6)*(1.5+math.Pow(d/L, 1.9)*math.Pow(d/D, -0.6)),
+		1 + 1.4*(1+B/L)*math.Pow(d/B, 0.9),
+	}
+}
+
+// calcDynamicCoefficients returns the dynamic stiffness coefficients of Gazetas (1991) for the dimensionless
+// frequency a0 = ωB/Vs.
+func calcDynamicCoefficients(geo geometry, a0 float64) [6]float64 {
+	ratio := geo.L / geo.B
+	kz := 1 - (0.4+0.2/ratio)*a0*a0/(10/(1+3*(ratio-1))+a0*a0)
+	return [6]float64{
+		math.Max(kz, 0),
+		1,
+		1,
+		math.Max(1-0.2*a0, 0),
+		math.Max(1-0.26*a0, 0),
+		math.Max(1-0.14*a0, 0),
+	}
+}
+
+// calcDashpots returns the radiation dashpots of Gazetas (1991). The translational dashpots use the low frequency
+// values and the rotational ones the approximate coefficients 0.3·a0²/(1 + a0²) for rocking and a0²/(2 + a0²) for
+// torsion. For an embedded foundation the side walls in contact with the soil to depth d add ρ·Vs·Aw to the vertical
+// dashpot, and ρ·Vs times the wall area parallel to the motion plus ρ·VLa times the wall area normal to it to the
+// horizontal dashpots. Half of the wall of a round foundation is taken in shear and half in compression. The rocking
+// and torsion dashpots are those of the base only.
+func calcDashpots(geo geometry, rho, Vs, VLa, a0, d float64) [6]float64 {
+	cr := 0.3 * a0 * a0 / (1 + a0*a0)
+	ct := a0 * a0 / (2 + a0*a0)
+
+	// side wall areas normal to the x and y axes
+	wallX, wallY := 4*geo.B*d, 4*geo.L*d
+	if geo.isRound {
+		wallX, wallY = math.Pi*geo.B*d, math.Pi*geo.B*d
+	}
+
+	return [6]float64{
+		rho*VLa*geo.Ab + rho*Vs*(wallX+wallY),
+		rho*Vs*geo.Ab + rho*VLa*wallX + rho*Vs*wallY,
+		rho*Vs*geo.Ab + rho*Vs*wallX + rho*VLa*wallY,
+		rho * VLa * geo.Ibx * cr,
+		rho * VLa * geo.Iby * cr,
+		rho * Vs * geo.J * ct,
+	}
+}
+
+// CalcImpedance calculates the frequency dependent springs and dashpots of a rigid surface or embedded foundation on
+// a homogeneous half space by Gazetas (1991).
+//
+// Parameters:
+//
+// - soilProfile (models.SoilProfile): The soil profile. The shear wave velocity, unit weight, Poisson's ratio and
+// damping ratio of the layer at the foundation depth are used.
+//
+// - foundationData (models.Foundation): The foundation data. Round foundations use the width as the diameter. The
+// side walls are assumed to be in full contact with the soil. Their radiation is added to the vertical and horizontal
+// dashpots only, so the rocking and torsion damping of embedded foundations is conservative.
+//
+// - frequency (float64): Excitation frequency (in Hz).
+//
+// - groundAcceleration (float64): Effective ground acceleration (in g) for the strain compatible shear modulus and
+// damping of Eurocode 8. Use 0 for small strains.
+//
+// Returns:
+//
+// - result (Result)
+func CalcImpedance(
+	soilProfile models.SoilProfile, foundationData models.Foundation, frequency, groundAcceleration float64,
+) Result {
+	Df := foundationData.FoundationDepth
+	layer := soilProfile.Layers[soilProfile.GetLayerIndex(Df)]
+	unitWeight := layer.DryUnitWeight
+	if soilProfile.Gwt <= Df {
+		unitWeight = layer.SaturatedUnitWeight
+	}
+	rho := unitWeight / g
+	nu := layer.PoissonsRatio
+
+	G0 := rho * math.Pow(layer.ShearWaveVelocity, 2)
+	G := G0 * pkg.Interp(groundAcceleration, reductionAcceleration, reductionModulus)
+	Vs := math.Sqrt(G / rho)
+	VLa := 3.4 * Vs / (math.Pi * (1 - nu))
+	damping := math.Max(layer.DampingRatio/100, pkg.Interp(groundAcceleration, reductionAcceleration, reductionDamping))
+
+	geo := getGeometry(foundationData)
+	omega := 2 * math.Pi * frequency
+	a0 := omega * geo.B / Vs
+
+	static := calcStaticStiffness(geo, G, nu)
+	embedment := calcEmbedmentFactors(geo, Df)
+	coefficients := calcDynamicCoefficients(geo, a0)
+	dashpots := calcDashpots(geo, rho, Vs, VLa, a0, Df)
+
+	var impedances [6]Impedance
+	for i := range impedances {
+		stiffness := static[i] * embedment[i] * coefficients[i]
+		var radiation float64
+		if stiffness > 0 {
+			radiation = omega * dashpots[i] / (2 * stiffness)
+		}
+		impedances[i] = Impedance{
+			StaticStiffness:    static[i],
+			EmbedmentFactor:    embedment[i],
+			DynamicCoefficient: coefficients[i],
+			Stiffness:          stiffness,
+			Dashpot:            dashpots[i],
+			RadiationDamping:   radiation,
+			TotalDamping:       radiation + damping,
+		}
+	}
+
+	return Result{
+		MaxShearModulus:   G0,
+		ShearModulus:      G,
+		ShearWaveVelocity: Vs,
+		LysmerVelocity:    VLa,
+		Density:           rho,
+		DampingRatio:      damping,
+		A0:                a0,
+		Vertical:          impedances[0],
+		HorizontalX:       impedances[1],
+		HorizontalY:       impedances[2],
+		RockingX:          impedances[3],
+		RockingY:          impedances[4],
+		Torsion:           impedances[5],
+	}
+}
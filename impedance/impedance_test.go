@@ -0,0 +1,56 @@
+package impedance
+
+import (
+	"testing"
+
+	dt "github.com/geoport/GeoGo/data"
+	pkg "github.com/geoport/GeoGo/internal"
+	"github.com/geoport/GeoGo/models"
+)
+
+func TestCalcImpedance(t *testing.T) {
+	soilProfile := dt.SoilProfile.Copy()
+	soilProfile.CalcLayerDepths()
+
+	output := CalcImpedance(soilProfile, dt.FoundationData, 5, 0.2)
+	values := []float64{output.MaxShearModulus, output.ShearModulus, output.DampingRatio, output.A0}
+	expected := []float64{19380.73, 9690.37, 0.06, 0.6835}
+	if !pkg.AssertFloatArray(values, expected, 0.01) {
+		t.Errorf("Got %v, want %v", values, expected)
+	}
+
+	values = []float64{
+		output.Vertical.StaticStiffness, output.Vertical.EmbedmentFactor, output.Vertical.DynamicCoefficient,
+		output.Vertical.Dashpot, output.Vertical.RadiationDamping,
+	}
+	expected = []float64{531577.83, 1.1782, 0.9213, 20271.80, 0.5519}
+	if !pkg.AssertFloatArray(values, expected, 0.01) {
+		t.Errorf("Got %v, want %v for vertical", values, expected)
+	}
+
+	values = []float64{output.HorizontalX.Dashpot, output.HorizontalY.Dashpot, output.RockingX.Dashpot}
+	expected = []float64{14849.09, 16204.77, 12109.70}
+	if !pkg.AssertFloatArray(values, expected, 0.01) {
+		t.Errorf("Got %v, want %v for dashpots", values, expected)
+	}
+
+	values = []float64{
+		output.HorizontalX.StaticStiffness, output.HorizontalY.StaticStiffness,
+		output.RockingX.StaticStiffness, output.RockingY.StaticStiffness, output.Torsion.StaticStiffness,
+	}
+	expected = []float64{382574.53, 410261.29, 13276356.59, 39663979.36, 33898387.17}
+	if !pkg.AssertFloatArray(values, expected, 0.01) {
+		t.Errorf("Got %v, want %v for static stiffnesses", values, expected)
+	}
+
+	round := models.Foundation{FoundationWidth: 4, FoundationType: "round"}
+	output = CalcImpedance(soilProfile, round, 5, 0)
+	values = []float64{
+		output.Vertical.StaticStiffness, output.HorizontalX.StaticStiffness, output.RockingX.StaticStiffness,
+		output.Torsion.StaticStiffness, output.Vertical.EmbedmentFactor,
+	}
+	expected = []float64{258409.79, 193807.34, 689092.76, 826911.31, 1}
+	if !pkg.AssertFloatArray(values, expected, 0.01) {
+		t.Errorf("Got %v, want %v for round foundation", values, expected)
+	}
+}
@@ -0,0 +1,29 @@
+package impedance
+
+type Result struct {
+	MaxShearModulus   float64   `json:"maxShearModulus"`   // t/m2, small strain
+	ShearModulus      float64   `json:"shearModulus"`      // t/m2, strain compatible
+	ShearWaveVelocity float64   `json:"shearWaveVelocity"` // m/s, strain compatible
+	LysmerVelocity    float64   `json:"lysmerVelocity"`    // m/s, Lysmer's analog velocity
+	Density           float64   `json:"density"`           // t.s2/m4
+	DampingRatio      float64   `json:"dampingRatio"`      // material damping
+	A0                float64   `json:"a0"`                // dimensionless frequency
+	Vertical          Impedance `json:"vertical"`
+	HorizontalX       Impedance `json:"horizontalX"` // along the foundation length
+	HorizontalY       Impedance `json:"horizontalY"` // along the foundation width
+	RockingX          Impedance `json:"rockingX"`    // about the axis along the foundation length
+	RockingY          Impedance `json:"rockingY"`    // about the axis along the foundation width
+	Torsion           Impedance `json:"torsion"`
+}
+
+// Impedance holds the spring and dashpot of a degree of freedom. Translational values are in t/m and t.s/m,
+// rotational values in t.m/rad and t.m.s/rad.
+type Impedance struct {
+	StaticStiffness    float64 `json:"staticStiffness"` // surface foundation
+	EmbedmentFactor    float64 `json:"embedmentFactor"`
+	DynamicCoefficient float64 `json:"dynamicCoefficient"`
+	Stiffness          float64 `json:"stiffness"` // dynamic stiffness of the embedded foundation
+	Dashpot            float64 `json:"dashpot"`
+	RadiationDamping   float64 `json:"radiationDamping"`
+	TotalDamping       float64 `json:"totalDamping"`
+}
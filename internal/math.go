@@ -5,3 +5,158 @@ import "math"
 func Radian(degrees float64) float64 {
 	return degrees * math.Pi / 180
 }
+
+// Interp returns the linearly interpolated value of fp at x. xp must be in increasing order; values outside the
+// range are clamped to the first or last element of fp.
+func Interp(x float64, xp, fp []float64) float64 {
+	if x <= xp[0] {
+		return fp[0]
+	}
+	last := len(xp) - 1
+	if x >= xp[last] {
+		return fp[last]
+	}
+	for i := 1; i <= last; i++ {
+		if x <= xp[i] {
+			return fp[i-1] + (fp[i]-fp[i-1])*(x-xp[i-1])/(xp[i]-xp[i-1])
+		}
+	}
+	return fp[last]
+}
+
+// LinearFit returns the slope and intercept of the least squares line that fits the given points.
+func LinearFit(x, y []float64) (float64, float64) {
+	n := float64(len(x))
+	var sumX, sumY, sumXY, sumX2 float64
+	for i := range x {
+		sumX += x[i]
+		sumY += y[i]
+		sumXY += x[i] * y[i]
+		sumX2 += x[i] * x[i]
+	}
+	slope := (n*sumXY - sumX*sumY) / (n*sumX2 - sumX*sumX)
+	intercept := (sumY - slope*sumX) / n
+
+	return slope, intercept
+}
+
+// SolveLinear solves the linear system A·x = b by Gaussian elimination with partial pivoting. A and b are not
+// modified.
+func SolveLinear(A [][]float64, b []float64) []float64 {
+	n := len(b)
+	m := make([][]float64, n)
+	for i := range A {
+		m[i] = make([]float64, n+1)
+		copy(m[i], A[i])
+		m[i][n] = b[i]
+	}
+
+	for col := 0; col < n; col++ {
+		pivot := col
+		for row := col + 1; row < n; row++ {
+			if math.Abs(m[row][col]) > math.Abs(m[pivot][col]) {
+				pivot = row
+			}
+		}
+		m[col], m[pivot] = m[pivot], m[col]
+		if m[col][col] == 0 {
+			continue
+		}
+		for row := col + 1; row < n; row++ {
+			factor := m[row][col] / m[col][col]
+			if factor == 0 {
+				continue
+			}
+			for k := col; k <= n; k++ {
+				m[row][k] -= factor * m[col][k]
+			}
+		}
+	}
+
+	x := make([]float64, n)
+	for row := n - 1; row >= 0; row-- {
+		sum := m[row][n]
+		for k := row + 1; k < n; k++ {
+			sum -= m[row][k] * x[k]
+		}
+		if m[row][row] != 0 {
+			x[row] = sum / m[row][row]
+		}
+	}
+	return x
+}
+
+// BandMatrix is a symmetric matrix that stores only the diagonal and the upper half of the band. Row i holds the
+// terms A[i][i] to A[i][i+Bandwidth].
+type BandMatrix struct {
+	Size      int
+	Bandwidth int
+	values    [][]float64
+}
+
+// NewBandMatrix returns a zero symmetric band matrix with the given size and half bandwidth.
+func NewBandMatrix(size, bandwidth int) BandMatrix {
+	values := make([][]float64, size)
+	for i := range values {
+		values[i] = make([]float64, bandwidth+1)
+	}
+	return BandMatrix{Size: size, Bandwidth: bandwidth, values: values}
+}
+
+// Add adds the value to the term (i, j). Terms below the diagonal are ignored since the matrix is symmetric, so a
+// full symmetric element matrix can be assembled term by term.
+func (m *BandMatrix) Add(i, j int, value float64) {
+	if j >= i {
+		m.values[i][j-i] += value
+	}
+}
+
+// Get returns the term (i, j) of the matrix.
+func (m *BandMatrix) Get(i, j int) float64 {
+	if j < i {
+		i, j = j, i
+	}
+	if j-i > m.Bandwidth {
+		return 0
+	}
+	return m.values[i][j-i]
+}
+
+// SolveBanded solves the linear system A·x = b of a symmetric positive definite band matrix. Gaussian elimination is
+// done without pivoting on the stored upper band, which stays symmetric during the elimination. A and b are not
+// modified.
+func SolveBanded(A BandMatrix, b []float64) []float64 {
+	n := A.Size
+	bw := A.Bandwidth
+	m := make([][]float64, n)
+	for i := range m {
+		m[i] = make([]float64, bw+1)
+		copy(m[i], A.values[i])
+	}
+	x := make([]float64, n)
+	copy(x, b)
+
+	for k := 0; k < n; k++ {
+		last := int(math.Min(float64(k+bw), float64(n-1)))
+		for i := k + 1; i <= last; i++ {
+			if m[k][i-k] == 0 {
+				continue
+			}
+			factor := m[k][i-k] / m[k][0]
+			for j := i; j <= last; j++ {
+				m[i][j-i] -= factor * m[k][j-k]
+			}
+			x[i] -= factor * x[k]
+		}
+	}
+
+	for i := n - 1; i >= 0; i-- {
+		last := int(math.Min(float64(i+bw), float64(n-1)))
+		sum := x[i]
+		for j := i + 1; j <= last; j++ {
+			sum -= m[i][j-i] * x[j]
+		}
+		x[i] = sum / m[i][0]
+	}
+	return x
+}
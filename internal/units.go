@@ -0,0 +1,4 @@
+package internal
+
+// MPa to t/m2
+const MPa = 101.97
@@ -0,0 +1,108 @@
+package kinematic_analysis
+
+import (
+	"math"
+
+	pkg "github.com/geoport/GeoGo/internal"
+)
+
+func degree(radian float64) float64 {
+	return radian * 180 / math.Pi
+}
+
+// normalizeAngle returns the angle in [0, 360) degrees.
+func normalizeAngle(angle float64) float64 {
+	angle = math.Mod(angle, 360)
+	if angle < 0 {
+		angle += 360
+	}
+	return angle
+}
+
+// angleDifference returns the smallest absolute difference between two azimuths in degrees.
+func angleDifference(a, b float64) float64 {
+	diff := math.Abs(normalizeAngle(a) - normalizeAngle(b))
+	return math.Min(diff, 360-diff)
+}
+
+// getPole returns the pole (normal) of the plane pointing to the lower hemisphere.
+func getPole(plane Plane) Line {
+	return Line{Trend: normalizeAngle(plane.DipDirection + 180), Plunge: 90 - plane.Dip}
+}
+
+// toVector returns the north, east and down components of the unit vector along the line.
+func toVector(line Line) [3]float64 {
+	trend := pkg.Radian(line.Trend)
+	plunge := pkg.Radian(line.Plunge)
+	return [3]float64{
+		math.Cos(plunge) * math.Cos(trend),
+		math.Cos(plunge) * math.Sin(trend),
+		math.Sin(plunge),
+	}
+}
+
+// calcIntersection returns the line of intersection of two planes. The second return value is false if the planes
+// are parallel.
+func calcIntersection(plane1, plane2 Plane) (Line, bool) {
+	n1 := toVector(getPole(plane1))
+	n2 := toVector(getPole(plane2))
+
+	v := [3]float64{
+		n1[1]*n2[2] - n1[2]*n2[1],
+		n1[2]*n2[0] - n1[0]*n2[2],
+		n1[0]*n2[1] - n1[1]*n2[0],
+	}
+	norm := math.Sqrt(v[0]*v[0] + v[1]*v[1] + v[2]*v[2])
+	if norm < 1e-9 {
+		return Line{}, false
+	}
+	for i := range v {
+		v[i] /= norm
+	}
+	if v[2] < 0 {
+		v = [3]float64{-v[0], -v[1], -v[2]}
+	}
+
+	return Line{
+		Trend:  normalizeAngle(degree(math.Atan2(v[1], v[0]))),
+		Plunge: degree(math.Asin(math.Min(v[2], 1))),
+	}, true
+}
+
+// calcApparentDip returns the apparent dip of the plane in the given direction.
+func calcApparentDip(plane Plane, direction float64) float64 {
+	return degree(math.Atan(math.Tan(pkg.Radian(plane.Dip)) * math.Cos(pkg.Radian(direction-plane.DipDirection))))
+}
+
+// project returns the lower hemisphere stereographic (equal angle) or Lambert (equal area) projection of the line.
+func project(line Line, equalArea bool) Point {
+	var r float64
+	halfAngle := pkg.Radian(45 - line.Plunge/2)
+	if equalArea {
+		r = math.Sqrt2 * math.Sin(halfAngle)
+	} else {
+		r = math.Tan(halfAngle)
+	}
+	trend := pkg.Radian(line.Trend)
+	return Point{X: r * math.Sin(trend), Y: r * math.Cos(trend)}
+}
+
+// projectGreatCircle returns the projection of the plane as points in 5 degree steps of its strike.
+func projectGreatCircle(plane Plane, equalArea bool) []Point {
+	var points []Point
+	for angle := -90.; angle <= 90; angle += 5 {
+		direction := plane.DipDirection + angle
+		plunge := math.Max(calcApparentDip(plane, direction), 0)
+		points = append(points, project(Line{Trend: normalizeAngle(direction), Plunge: plunge}, equalArea))
+	}
+	return points
+}
+
+// projectSmallCircle returns the projection of the cone of lines with the given plunge around the vertical axis.
+func projectSmallCircle(plunge float64, equalArea bool) []Point {
+	var points []Point
+	for trend := 0.; trend <= 360; trend += 5 {
+		points = append(points, project(Line{Trend: trend, Plunge: plunge}, equalArea))
+	}
+	return points
+}
@@ -0,0 +1,129 @@
+package kinematic_analysis
+
+// isPlanarSliding checks Markland's test for planar sliding: the plane dips out of the slope within the lateral
+// limit, daylights on the slope face and is steeper than the friction angle.
+func isPlanarSliding(plane, slope Plane, frictionAngle, lateralLimit float64) bool {
+	if angleDifference(plane.DipDirection, slope.DipDirection) > lateralLimit {
+		return false
+	}
+	return plane.Dip > frictionAngle && plane.Dip < calcApparentDip(slope, plane.DipDirection)
+}
+
+// isWedgeSliding checks Markland's test for wedge sliding: the intersection line daylights on the slope face and
+// plunges steeper than the friction angle.
+func isWedgeSliding(intersection Line, slope Plane, frictionAngle float64) bool {
+	if angleDifference(intersection.Trend, slope.DipDirection) >= 90 {
+		return false
+	}
+	return intersection.Plunge > frictionAngle && intersection.Plunge < calcApparentDip(slope, intersection.Trend)
+}
+
+// isFlexuralToppling checks Goodman & Bray's condition for flexural toppling: the plane dips into the slope within
+// the lateral limit and its normal is flatter than the slope face minus the friction angle.
+func isFlexuralToppling(plane, slope Plane, frictionAngle, lateralLimit float64) bool {
+	if angleDifference(plane.DipDirection, slope.DipDirection+180) > lateralLimit {
+		return false
+	}
+	return 90-plane.Dip+frictionAngle < slope.Dip
+}
+
+// isDirectToppling checks whether the intersection line forms columns that lean out of the slope: it plunges into
+// the slope within the lateral limit and is steeper than the normal of the slope face.
+func isDirectToppling(intersection Line, slope Plane, lateralLimit float64) bool {
+	if angleDifference(intersection.Trend, slope.DipDirection+180) > lateralLimit {
+		return false
+	}
+	return intersection.Plunge > 90-slope.Dip
+}
+
+// hasBasalPlane checks whether there is a set other than the given ones that can act as the base of toppling
+// columns: it dips out of the slope within the lateral limit at an angle less than the friction angle.
+func hasBasalPlane(sets []Plane, exclude [2]int, slope Plane, frictionAngle, lateralLimit float64) bool {
+	for i, plane := range sets {
+		if i == exclude[0] || i == exclude[1] {
+			continue
+		}
+		if plane.Dip < frictionAngle && angleDifference(plane.DipDirection, slope.DipDirection) <= lateralLimit {
+			return true
+		}
+	}
+	return false
+}
+
+// calcStereonet returns the projections of the slope face, discontinuity sets, their intersections and the friction
+// cone.
+func calcStereonet(slope Plane, sets []Plane, intersections []Line, frictionAngle float64, equalArea bool) Stereonet {
+	stereonet := Stereonet{
+		SlopeGreatCircle: projectGreatCircle(slope, equalArea),
+		SlopePole:        project(getPole(slope), equalArea),
+		FrictionCircle:   projectSmallCircle(frictionAngle, equalArea),
+	}
+	for _, plane := range sets {
+		stereonet.GreatCircles = append(stereonet.GreatCircles, projectGreatCircle(plane, equalArea))
+		stereonet.Poles = append(stereonet.Poles, project(getPole(plane), equalArea))
+	}
+	for _, line := range intersections {
+		stereonet.Intersections = append(stereonet.Intersections, project(line, equalArea))
+	}
+	return stereonet
+}
+
+// CalcKinematicAnalysis checks the kinematic feasibility of planar sliding, wedge sliding, flexural toppling and
+// direct toppling of a rock slope for the given discontinuity sets.
+//
+// Parameters:
+//
+// - slope (Plane): Dip and dip direction of the slope face (in degrees).
+//
+// - frictionAngle (float64): Friction angle of the discontinuities (in degrees).
+//
+// - sets (Plane[]): Dip and dip direction of the discontinuity sets (in degrees).
+//
+// - lateralLimit (float64): Allowed difference between the failure and slope directions (in degrees), usually 20.
+//
+// Returns:
+//
+// - result (Result): Checks of each failure mode and the stereonet data in equal angle and equal area projections.
+func CalcKinematicAnalysis(slope Plane, frictionAngle float64, sets []Plane, lateralLimit float64) Result {
+	var result Result
+	var intersections []Line
+
+	for i, plane := range sets {
+		result.PlanarSliding = append(result.PlanarSliding, PlaneCheck{
+			Set:        i,
+			IsCritical: isPlanarSliding(plane, slope, frictionAngle, lateralLimit),
+		})
+		result.FlexuralToppling = append(result.FlexuralToppling, PlaneCheck{
+			Set:        i,
+			IsCritical: isFlexuralToppling(plane, slope, frictionAngle, lateralLimit),
+		})
+	}
+
+	for i := range sets {
+		for j := i + 1; j < len(sets); j++ {
+			intersection, ok := calcIntersection(sets[i], sets[j])
+			if !ok {
+				continue
+			}
+			intersections = append(intersections, intersection)
+			pair := [2]int{i, j}
+
+			result.WedgeSliding = append(result.WedgeSliding, IntersectionCheck{
+				Sets:         pair,
+				Intersection: intersection,
+				IsCritical:   isWedgeSliding(intersection, slope, frictionAngle),
+			})
+			result.DirectToppling = append(result.DirectToppling, IntersectionCheck{
+				Sets:         pair,
+				Intersection: intersection,
+				IsCritical: isDirectToppling(intersection, slope, lateralLimit) &&
+					hasBasalPlane(sets, pair, slope, frictionAngle, lateralLimit),
+			})
+		}
+	}
+
+	result.EqualAngle = calcStereonet(slope, sets, intersections, frictionAngle, false)
+	result.EqualArea = calcStereonet(slope, sets, intersections, frictionAngle, true)
+
+	return result
+}
@@ -0,0 +1,58 @@
+package kinematic_analysis
+
+import (
+	"testing"
+
+	pkg "github.com/geoport/GeoGo/internal"
+)
+
+func TestCalcKinematicAnalysis(t *testing.T) {
+	slope := Plane{Dip: 60, DipDirection: 90}
+	sets := []Plane{
+		{Dip: 45, DipDirection: 80},
+		{Dip: 50, DipDirection: 130},
+		{Dip: 50, DipDirection: 50},
+		{Dip: 75, DipDirection: 270},
+	}
+
+	output := CalcKinematicAnalysis(slope, 30, sets, 20)
+
+	expectedPlanar := []bool{true, false, false, false}
+	expectedFlexural := []bool{false, false, false, true}
+	for i := range sets {
+		if output.PlanarSliding[i].IsCritical != expectedPlanar[i] {
+			t.Errorf("Got %v, want %v for planar sliding of set %v", output.PlanarSliding[i].IsCritical, expectedPlanar[i], i)
+		}
+		if output.FlexuralToppling[i].IsCritical != expectedFlexural[i] {
+			t.Errorf("Got %v, want %v for flexural toppling of set %v", output.FlexuralToppling[i].IsCritical, expectedFlexural[i], i)
+		}
+	}
+
+	if len(output.WedgeSliding) != 6 {
+		t.Fatalf("Got %v, want %v intersections", len(output.WedgeSliding), 6)
+	}
+	wedge := output.WedgeSliding[3]
+	if wedge.Sets != [2]int{1, 2} || !wedge.IsCritical {
+		t.Errorf("Got %v (%v), want %v (%v) for wedge sliding", wedge.Sets, wedge.IsCritical, [2]int{1, 2}, true)
+	}
+	if !pkg.AssertFloat(wedge.Intersection.Trend, 90, 0.1) || !pkg.AssertFloat(wedge.Intersection.Plunge, 42.4, 0.1) {
+		t.Errorf("Got %v, want %v for intersection", wedge.Intersection, Line{Trend: 90, Plunge: 42.4})
+	}
+	for _, check := range output.DirectToppling {
+		if check.IsCritical {
+			t.Errorf("Got %v, want %v for direct toppling of sets %v", check.IsCritical, false, check.Sets)
+		}
+	}
+
+	pole := output.EqualAngle.Poles[0]
+	if !pkg.AssertFloat(pole.X, -0.408, 0.001) || !pkg.AssertFloat(pole.Y, -0.072, 0.001) {
+		t.Errorf("Got %v, want %v for equal angle pole", pole, Point{X: -0.408, Y: -0.072})
+	}
+	pole = output.EqualArea.Poles[0]
+	if !pkg.AssertFloat(pole.X, -0.533, 0.001) || !pkg.AssertFloat(pole.Y, -0.094, 0.001) {
+		t.Errorf("Got %v, want %v for equal area pole", pole, Point{X: -0.533, Y: -0.094})
+	}
+	if len(output.EqualArea.GreatCircles[0]) != 37 {
+		t.Errorf("Got %v, want %v points for great circle", len(output.EqualArea.GreatCircles[0]), 37)
+	}
+}
@@ -0,0 +1,48 @@
+package kinematic_analysis
+
+// Plane is a discontinuity set or slope face defined by its dip and dip direction in degrees.
+type Plane struct {
+	Dip          float64 `json:"dip"`
+	DipDirection float64 `json:"dipDirection"`
+}
+
+// Line is a line defined by its trend and plunge in degrees.
+type Line struct {
+	Trend  float64 `json:"trend"`
+	Plunge float64 `json:"plunge"`
+}
+
+// Point is a point on a stereonet of unit radius where X points to east and Y points to north.
+type Point struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+type Result struct {
+	PlanarSliding    []PlaneCheck        `json:"planarSliding"`
+	WedgeSliding     []IntersectionCheck `json:"wedgeSliding"`
+	FlexuralToppling []PlaneCheck        `json:"flexuralToppling"`
+	DirectToppling   []IntersectionCheck `json:"directToppling"`
+	EqualAngle       Stereonet           `json:"equalAngle"`
+	EqualArea        Stereonet           `json:"equalArea"`
+}
+
+type PlaneCheck struct {
+	Set        int  `json:"set"`
+	IsCritical bool `json:"isCritical"`
+}
+
+type IntersectionCheck struct {
+	Sets         [2]int `json:"sets"`
+	Intersection Line   `json:"intersection"`
+	IsCritical   bool   `json:"isCritical"`
+}
+
+type Stereonet struct {
+	SlopeGreatCircle []Point   `json:"slopeGreatCircle"`
+	SlopePole        Point     `json:"slopePole"`
+	GreatCircles     [][]Point `json:"greatCircles"`
+	Poles            []Point   `json:"poles"`
+	Intersections    []Point   `json:"intersections"`
+	FrictionCircle   []Point   `json:"frictionCircle"`
+}
@@ -0,0 +1,142 @@
+package machine_foundation
+
+import (
+	"math"
+
+	"github.com/geoport/GeoGo/impedance"
+	"github.com/geoport/GeoGo/models"
+)
+
+// gravitational acceleration in m/s2
+const g = 9.81
+
+// the natural frequency must be at least 20% away from the operating frequency
+const resonanceMargin = 0.2
+
+// Peak velocity limits (in mm/s) of the general machinery criteria of Baxter & Bernhard (1967). Velocities up to
+// "slightly rough" are accepted.
+var (
+	velocityLimits  = []float64{0.127, 0.254, 0.508, 1.016, 2.032, 4.064, 8.001, 16.002}
+	velocityClasses = []string{
+		"extremely smooth", "very smooth", "smooth", "very good", "good", "fair", "slightly rough", "rough",
+		"very rough",
+	}
+	acceptableLimit = 8.001
+)
+
+// getVelocityClass returns the Baxter & Bernhard class of the given peak velocity (in mm/s).
+func getVelocityClass(velocity float64) string {
+	for i, limit := range velocityLimits {
+		if velocity <= limit {
+			return velocityClasses[i]
+		}
+	}
+	return velocityClasses[len(velocityClasses)-1]
+}
+
+// calcMode returns the steady state response of a damped single degree of freedom system to a harmonic force. The
+// damping ratio is the radiation damping of the dashpot plus the material damping of the soil.
+//
+// Parameters:
+//
+// - K (float64): Stiffness.
+//
+// - C (float64): Dashpot coefficient.
+//
+// - m (float64): Mass or mass moment of inertia.
+//
+// - materialDamping (float64): Material damping ratio of the soil.
+//
+// - force (float64): Amplitude of the harmonic force or moment.
+//
+// - omega (float64): Operating circular frequency (in rad/s).
+//
+// - arm (float64): Lever arm converting rotation to displacement (in meters), 1 for translational modes.
+//
+// Returns:
+//
+// - mode (Mode)
+func calcMode(K, C, m, materialDamping, force, omega, arm float64) Mode {
+	omegaN := math.Sqrt(K / m)
+	D := C/(2*math.Sqrt(K*m)) + materialDamping
+	r := omega / omegaN
+
+	M := 1 / math.Sqrt(math.Pow(1-r*r, 2)+math.Pow(2*D*r, 2))
+	amplitude := force / K * M * arm * 1000
+	velocity := amplitude * omega
+
+	return Mode{
+		Stiffness:           K,
+		Dashpot:             C,
+		Inertia:             m,
+		NaturalFrequency:    omegaN / (2 * math.Pi),
+		DampingRatio:        D,
+		FrequencyRatio:      r,
+		MagnificationFactor: M,
+		Amplitude:           amplitude,
+		PeakVelocity:        velocity,
+		VelocityClass:       getVelocityClass(velocity),
+		IsResonant:          math.Abs(r-1) < resonanceMargin,
+		IsAcceptable:        velocity <= acceptableLimit && math.Abs(r-1) >= resonanceMargin,
+	}
+}
+
+// CalcBlockVibration calculates the natural frequencies and steady state amplitudes of a rigid block foundation under
+// the harmonic unbalanced forces of a machine. The vertical mode uses Lysmer's analog with the equivalent radius of
+// the base and the embedment factor of Gazetas (1991), sliding and rocking use the impedance functions of Gazetas
+// (1991) at the operating frequency. Sliding and rocking are treated as uncoupled modes. The material damping of the
+// soil is added to the radiation damping of each mode.
+//
+// Parameters:
+//
+// - soilProfile (models.SoilProfile): The soil profile. The layer at the base of the block is used.
+//
+// - block (models.MachineBlock): The concrete block.
+//
+// - machine (models.Machine): The machine on the block.
+//
+// Returns:
+//
+// - result (Result)
+func CalcBlockVibration(soilProfile models.SoilProfile, block models.MachineBlock, machine models.Machine) Result {
+	B, L, H := block.Width, block.Length, block.Height
+	frequency := machine.OperatingSpeed / 60
+	omega := 2 * math.Pi * frequency
+
+	foundationData := models.Foundation{FoundationDepth: block.Depth, FoundationWidth: B, FoundationLength: L}
+	soil := impedance.CalcImpedance(soilProfile, foundationData, frequency, 0)
+	nu := soilProfile.Layers[soilProfile.GetLayerIndex(block.Depth)].PoissonsRatio
+
+	blockWeight := B * L * H * block.UnitWeight
+	totalWeight := blockWeight + machine.Weight
+	machineHeight := H + machine.Height
+	zc := (blockWeight*H/2 + machine.Weight*machineHeight) / totalWeight
+
+	mass := totalWeight / g
+	// mass moment of inertia about the rocking axis along the block length at the base
+	inertia := blockWeight/g*((B*B+H*H)/12+H*H/4) + machine.Weight/g*machineHeight*machineHeight
+
+	// Lysmer's analog with the stiffness increase of the embedment
+	R := math.Sqrt(B * L / math.Pi)
+	Kz := 4 * soil.ShearModulus * R / (1 - nu) * soil.Vertical.EmbedmentFactor
+	Cz := 3.4 * R * R * math.Sqrt(soil.ShearModulus*soil.Density) / (1 - nu)
+
+	xi := soil.DampingRatio
+	vertical := calcMode(Kz, Cz, mass, xi, machine.VerticalForce, omega, 1)
+	sliding := calcMode(
+		soil.HorizontalY.Stiffness, soil.HorizontalY.Dashpot, mass, xi, machine.HorizontalForce, omega, 1,
+	)
+	rocking := calcMode(
+		soil.RockingX.Stiffness, soil.RockingX.Dashpot, inertia, xi, machine.HorizontalForce*machine.ForceHeight, omega, H,
+	)
+
+	return Result{
+		OperatingFrequency: frequency,
+		TotalWeight:        totalWeight,
+		CenterOfGravity:    zc,
+		Vertical:           vertical,
+		Sliding:            sliding,
+		Rocking:            rocking,
+		IsSafe:             vertical.IsAcceptable && sliding.IsAcceptable && rocking.IsAcceptable,
+	}
+}
@@ -0,0 +1,57 @@
+package machine_foundation
+
+import (
+	"testing"
+
+	dt "github.com/geoport/GeoGo/data"
+	pkg "github.com/geoport/GeoGo/internal"
+)
+
+func TestCalcBlockVibration(t *testing.T) {
+	soilProfile := dt.SoilProfile.Copy()
+	soilProfile.CalcLayerDepths()
+
+	output := CalcBlockVibration(soilProfile, dt.MachineBlockData, dt.MachineData)
+	values := []float64{output.OperatingFrequency, output.TotalWeight, output.CenterOfGravity}
+	expected := []float64{25, 140, 1.2857}
+	if !pkg.AssertFloatArray(values, expected, 0.001) {
+		t.Errorf("Got %v, want %v", values, expected)
+	}
+
+	values = []float64{output.Vertical.Stiffness, output.Vertical.NaturalFrequency, output.Vertical.DampingRatio}
+	expected = []float64{469328.66, 28.86, 0.4987}
+	if !pkg.AssertFloatArray(values, expected, 0.01) {
+		t.Errorf("Got %v, want %v for vertical mode", values, expected)
+	}
+	if !output.Vertical.IsResonant || output.Vertical.IsAcceptable {
+		t.Errorf("Vertical mode should be resonant and not acceptable")
+	}
+
+	values = []float64{output.Sliding.NaturalFrequency, output.Rocking.NaturalFrequency}
+	expected = []float64{29.36, 36.47}
+	if !pkg.AssertFloatArray(values, expected, 0.01) {
+		t.Errorf("Got %v, want %v for sliding and rocking frequencies", values, expected)
+	}
+	values = []float64{output.Vertical.Amplitude, output.Sliding.Amplitude, output.Rocking.Amplitude}
+	expected = []float64{0.001185, 0.000763, 0.001971}
+	if !pkg.AssertFloatArray(values, expected, 1e-5) {
+		t.Errorf("Got %v, want %v for amplitudes", values, expected)
+	}
+	if output.Rocking.VelocityClass != "smooth" || !output.Rocking.IsAcceptable || output.IsSafe {
+		t.Errorf("Got %v (%v) for rocking and %v for the block", output.Rocking.VelocityClass, output.Rocking.IsAcceptable, output.IsSafe)
+	}
+
+	soilProfile.Layers[0].DampingRatio = 5
+	damped := CalcBlockVibration(soilProfile, dt.MachineBlockData, dt.MachineData)
+	if !pkg.AssertFloat(damped.Rocking.DampingRatio, output.Rocking.DampingRatio+0.05, 1e-9) {
+		t.Errorf("Got %v, want %v for rocking damping with material damping", damped.Rocking.DampingRatio, output.Rocking.DampingRatio+0.05)
+	}
+	soilProfile.Layers[0].DampingRatio = 0
+
+	machine := dt.MachineData
+	machine.OperatingSpeed = 900
+	output = CalcBlockVibration(soilProfile, dt.MachineBlockData, machine)
+	if !output.IsSafe {
+		t.Errorf("Got %v, want %v for 900 rpm", output.IsSafe, true)
+	}
+}
@@ -0,0 +1,27 @@
+package machine_foundation
+
+type Result struct {
+	OperatingFrequency float64 `json:"operatingFrequency"` // Hz
+	TotalWeight        float64 `json:"totalWeight"`        // ton, block and machine
+	CenterOfGravity    float64 `json:"centerOfGravity"`    // meter, height above the block base
+	Vertical           Mode    `json:"vertical"`
+	Sliding            Mode    `json:"sliding"`
+	Rocking            Mode    `json:"rocking"`
+	IsSafe             bool    `json:"isSafe"`
+}
+
+// Mode holds the response of a single vibration mode. Stiffness, dashpot and inertia are rotational for rocking.
+type Mode struct {
+	Stiffness           float64 `json:"stiffness"`
+	Dashpot             float64 `json:"dashpot"`
+	Inertia             float64 `json:"inertia"`          // mass (t.s2/m) or mass moment of inertia about the base (t.m.s2)
+	NaturalFrequency    float64 `json:"naturalFrequency"` // Hz
+	DampingRatio        float64 `json:"dampingRatio"`
+	FrequencyRatio      float64 `json:"frequencyRatio"` // operating frequency / natural frequency
+	MagnificationFactor float64 `json:"magnificationFactor"`
+	Amplitude           float64 `json:"amplitude"`    // mm, at the top of the block for rocking
+	PeakVelocity        float64 `json:"peakVelocity"` // mm/s
+	VelocityClass       string  `json:"velocityClass"`
+	IsResonant          bool    `json:"isResonant"`
+	IsAcceptable        bool    `json:"isAcceptable"`
+}
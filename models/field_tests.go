@@ -1,9 +1,28 @@
 package models
 
+import "math"
+
 type PressuremeterExp struct {
 	Depth            float64 `json:"depth"`
 	LimitPressure    float64 `json:"limitPressure"`
 	NetLimitPressure float64 `json:"netLimitPressure"`
+	EM               float64 `json:"EM"` // pressuremeter modulus
+	CreepPressure    float64 `json:"creepPressure"`
+}
+
+type PressuremeterReading struct {
+	Pressure float64 `json:"pressure"`
+	Volume   float64 `json:"volume"` // cm^3
+}
+
+// PressuremeterRawTest holds the readings of a pressuremeter test at a single depth before any correction.
+type PressuremeterRawTest struct {
+	Depth               float64                `json:"depth"`       // meter
+	ProbeVolume         float64                `json:"probeVolume"` // cm^3
+	GaugeHeight         float64                `json:"gaugeHeight"` // meter, height of the pressure gauge above the ground
+	Readings            []PressuremeterReading `json:"readings"`
+	MembraneCalibration []PressuremeterReading `json:"membraneCalibration"` // probe inflated in air
+	VolumeCalibration   []PressuremeterReading `json:"volumeCalibration"`   // probe inflated in a rigid tube
 }
 
 type Pressuremeter struct {
@@ -30,6 +49,34 @@ type CPT struct {
 	Exps []CptExp `json:"exps"`
 }
 
+// GetSegments returns the experiments between the given depths and their tributary thicknesses. Each experiment
+// represents the soil between the midpoints to its neighbouring experiments, the first one extends to the surface and
+// the last one ends at its depth.
+func (cpt *CPT) GetSegments(top, bottom float64) ([]CptExp, []float64) {
+	var segments []CptExp
+	var thicknesses []float64
+
+	exps := cpt.Exps
+	for i, exp := range exps {
+		upper := 0.
+		lower := exp.Depth
+		if i > 0 {
+			upper = (exps[i-1].Depth + exp.Depth) / 2
+		}
+		if i < len(exps)-1 {
+			lower = (exps[i+1].Depth + exp.Depth) / 2
+		}
+		upper = math.Max(upper, top)
+		lower = math.Min(lower, bottom)
+
+		if lower > upper {
+			segments = append(segments, exp)
+			thicknesses = append(thicknesses, lower-upper)
+		}
+	}
+	return segments, thicknesses
+}
+
 type SptExp struct {
 	Depth       float64 `json:"depth"`
 	N           int     `json:"N"`
@@ -43,6 +90,14 @@ type SptExp struct {
 	Beta        float64 `json:"beta"`
 }
 
+// GetN60 returns the energy corrected blow count of the experiment, or the field blow count if it is not given.
+func (exp SptExp) GetN60() float64 {
+	if exp.N60 > 0 {
+		return float64(exp.N60)
+	}
+	return float64(exp.N)
+}
+
 type SPT struct {
 	Exps                     []SptExp `json:"exps"`
 	EnergyCorrectionFactor   float64  `json:"energyCorrectionFactor"`
@@ -51,3 +106,13 @@ type SPT struct {
 	MakeCorrection           bool     `json:"makeCorrection"`
 	AverageN                 int64    `json:"averageN"`
 }
+
+type PlateLoadReading struct {
+	Pressure   float64 `json:"pressure"`   // t/m^2
+	Settlement float64 `json:"settlement"` // cm
+}
+
+type PlateLoadTest struct {
+	PlateWidth float64            `json:"plateWidth"` // meter, width or diameter of the plate
+	Readings   []PlateLoadReading `json:"readings"`
+}
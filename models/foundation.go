@@ -7,5 +7,7 @@ type Foundation struct {
 	FoundationLength           float64 `json:"foundationLength"`
 	SurfaceFrictionCoefficient float64 `json:"surfaceFrictionCoefficient"`
 	SlopeAngle                 float64 `json:"slopeAngle"`
+	SlopeHeight                float64 `json:"slopeHeight"`    // meter
+	SlopeSetback               float64 `json:"slopeSetback"`   // meter, distance between the foundation edge and the slope crest
 	FoundationType             string  `json:"foundationType"` // "square", "round" or "strip"
 }
@@ -0,0 +1,18 @@
+package models
+
+type Machine struct {
+	Weight          float64 `json:"weight"`          // ton
+	Height          float64 `json:"height"`          // meter, height of the machine's center of gravity above the block
+	OperatingSpeed  float64 `json:"operatingSpeed"`  // rpm
+	VerticalForce   float64 `json:"verticalForce"`   // ton, amplitude of the unbalanced vertical force
+	HorizontalForce float64 `json:"horizontalForce"` // ton, amplitude of the unbalanced force along the block width
+	ForceHeight     float64 `json:"forceHeight"`     // meter, height of the horizontal force above the block base
+}
+
+type MachineBlock struct {
+	Width      float64 `json:"width"`      // meter
+	Length     float64 `json:"length"`     // meter
+	Height     float64 `json:"height"`     // meter
+	Depth      float64 `json:"depth"`      // meter, embedment depth of the block base
+	UnitWeight float64 `json:"unitWeight"` // t/m^3
+}
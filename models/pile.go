@@ -0,0 +1,25 @@
+package models
+
+type Pile struct {
+	Diameter       float64 `json:"diameter"`       // meter
+	Length         float64 `json:"length"`         // meter, embedded length below the ground surface
+	Type           string  `json:"type"`           // "driven" or "bored"
+	Material       string  `json:"material"`       // "concrete", "steel" or "timber"
+	ElasticModulus float64 `json:"elasticModulus"` // t/m^2
+	YieldMoment    float64 `json:"yieldMoment"`    // t.m, bending moment capacity of the section
+}
+
+type PileGroup struct {
+	Rows    int     `json:"rows"`
+	Columns int     `json:"columns"`
+	Spacing float64 `json:"spacing"` // meter, center to center distance of the piles
+}
+
+type PileLoadReading struct {
+	Load       float64 `json:"load"`       // ton
+	Settlement float64 `json:"settlement"` // cm, pile head settlement
+}
+
+type PileLoadTest struct {
+	Readings []PileLoadReading `json:"readings"`
+}
@@ -0,0 +1,8 @@
+package models
+
+type RetainingWall struct {
+	Height        float64 `json:"height"`        // meter, retained height measured from the ground surface
+	WallFriction  float64 `json:"wallFriction"`  // degrees, friction angle between the wall and the soil
+	WallAngle     float64 `json:"wallAngle"`     // degrees, inclination of the back face from the vertical
+	BackfillSlope float64 `json:"backfillSlope"` // degrees, inclination of the backfill surface from the horizontal
+}
@@ -0,0 +1,10 @@
+package models
+
+type RockMass struct {
+	UCS            float64 `json:"UCS"`            // t/m^2, uniaxial compressive strength of intact rock
+	Mi             float64 `json:"mi"`             // Hoek-Brown constant of intact rock
+	GSI            float64 `json:"GSI"`            // geological strength index
+	RMR            float64 `json:"RMR"`            // rock mass rating (RMR89)
+	JointCondition float64 `json:"jointCondition"` // joint condition rating of RMR89 (0 - 30)
+	Disturbance    float64 `json:"disturbance"`    // disturbance factor (0 - 1)
+}
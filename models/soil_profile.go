@@ -39,6 +39,19 @@ type SoilLayer struct {
 	Kp                               float64 `json:"kp"`
 }
 
+// default point load index to uniaxial compressive strength conversion factor (Broch & Franklin, 1972)
+const defaultIs50Factor = 24.
+
+// EstimateUCS returns the uniaxial compressive strength estimated from the given point load index with the Kp of the
+// layer, or with 24 if Kp is not given.
+func (layer SoilLayer) EstimateUCS(IS50 float64) float64 {
+	factor := layer.Kp
+	if factor <= 0 {
+		factor = defaultIs50Factor
+	}
+	return factor * IS50
+}
+
 type SoilProfile struct {
 	Layers []SoilLayer `json:"layers"`
 	Gwt    float64     `json:"gwt"` // meter
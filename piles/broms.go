@@ -0,0 +1,154 @@
+package piles
+
+import (
+	"math"
+
+	pkg "github.com/geoport/GeoGo/internal"
+	"github.com/geoport/GeoGo/models"
+)
+
+// bisect returns the root of the increasing function between lo and hi. The second return value is false if the root
+// is not bracketed by lo and hi.
+func bisect(fn func(x float64) float64, lo, hi float64) (float64, bool) {
+	if fn(lo) > 0 || fn(hi) < 0 {
+		return 0, false
+	}
+	for i := 0; i < 200; i++ {
+		mid := (lo + hi) / 2
+		if fn(mid) > 0 {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return (lo + hi) / 2, true
+}
+
+func newBromsCapacity(mode string, load float64) BromsCapacity {
+	return BromsCapacity{
+		Mode:          mode,
+		UltimateLoad:  load,
+		SafetyFactor:  safetyFactor,
+		AllowableLoad: load / safetyFactor,
+	}
+}
+
+// calcBromsCohesiveFree returns the ultimate lateral load of a free head pile in cohesive soil. The soil resistance
+// is 9·cu·d below 1.5d.
+func calcBromsCohesiveFree(cu, d, L, e, My float64) BromsCapacity {
+	a := e + 1.5*d
+	c := L - 1.5*d
+
+	// depth f of the maximum moment below 1.5d for a short pile from 4f(a + f/2) = (L - 1.5d - f)^2
+	f := (-(4*a + 2*c) + math.Sqrt(math.Pow(4*a+2*c, 2)+4*c*c)) / 2
+	short := 9 * cu * d * f
+	if short*(a+0.5*f) <= My {
+		return newBromsCapacity("short", short)
+	}
+
+	long := 9 * cu * d * (-a + math.Sqrt(a*a+2*My/(9*cu*d)))
+	return newBromsCapacity("long", long)
+}
+
+// calcBromsCohesiveFixed returns the ultimate lateral load of a fixed head pile in cohesive soil.
+func calcBromsCohesiveFixed(cu, d, L, My float64) BromsCapacity {
+	short := 9 * cu * d * (L - 1.5*d)
+	if short*(0.5*L+0.75*d) <= My {
+		return newBromsCapacity("short", short)
+	}
+
+	// the head yields and the soil below the maximum moment resists H(1.5d + 0.5f) - My = 2.25·cu·d·g^2
+	intermediateF, ok := bisect(func(f float64) float64 {
+		g := L - 1.5*d - f
+		return 9*cu*d*f*(1.5*d+0.5*f) - My - 2.25*cu*d*g*g
+	}, 0, L-1.5*d)
+	intermediate := 9 * cu * d * intermediateF
+	if ok && intermediate*(1.5*d+0.5*intermediateF)-My <= My {
+		return newBromsCapacity("intermediate", intermediate)
+	}
+
+	long := 9 * cu * d * (-1.5*d + math.Sqrt(2.25*d*d+4*My/(9*cu*d)))
+	return newBromsCapacity("long", long)
+}
+
+// calcBromsCohesionlessFree returns the ultimate lateral load of a free head pile in cohesionless soil. The soil
+// resistance is 3·Kp·γ·z·d.
+func calcBromsCohesionlessFree(gamma, Kp, d, L, e, My float64) BromsCapacity {
+	// depth of the maximum moment where the shear is zero
+	calcF := func(H float64) float64 {
+		return math.Sqrt(H / (1.5 * gamma * d * Kp))
+	}
+
+	short := 0.5 * gamma * d * math.Pow(L, 3) * Kp / (e + L)
+	if short*(e+2*calcF(short)/3) <= My {
+		return newBromsCapacity("short", short)
+	}
+
+	// bracketed since the short pile check failed
+	long, _ := bisect(func(H float64) float64 {
+		return H*(e+2*calcF(H)/3) - My
+	}, 0, short)
+	return newBromsCapacity("long", long)
+}
+
+// calcBromsCohesionlessFixed returns the ultimate lateral load of a fixed head pile in cohesionless soil.
+func calcBromsCohesionlessFixed(gamma, Kp, d, L, My float64) BromsCapacity {
+	calcF := func(H float64) float64 {
+		return math.Sqrt(H / (1.5 * gamma * d * Kp))
+	}
+
+	short := 1.5 * gamma * math.Pow(L, 2) * d * Kp
+	if 2*short*L/3 <= My {
+		return newBromsCapacity("short", short)
+	}
+
+	intermediate := (0.5*gamma*d*math.Pow(L, 3)*Kp - My) / L
+	if 2*intermediate*calcF(intermediate)/3-My <= My {
+		return newBromsCapacity("intermediate", intermediate)
+	}
+
+	// bracketed since the intermediate pile check failed
+	long, _ := bisect(func(H float64) float64 {
+		return 2*H*calcF(H)/3 - 2*My
+	}, 0, short)
+	return newBromsCapacity("long", long)
+}
+
+// CalcBromsCapacity calculates the ultimate lateral capacity of a single pile by Broms (1964) for free and fixed
+// head conditions.
+//
+// Parameters:
+//
+// - soilProfile (models.SoilProfile): The soil profile. The properties of the layer at the pile tip are used for
+// the whole pile. Cohesive layers with undrained shear strength are analyzed as cohesive soil.
+//
+// - pile (models.Pile): The pile data. YieldMoment is the moment capacity of the pile section.
+//
+// - eccentricity (float64): Height of the lateral load above the ground surface (in meters), used for free head.
+//
+// Returns:
+//
+// - result (BromsResult)
+func CalcBromsCapacity(soilProfile models.SoilProfile, pile models.Pile, eccentricity float64) BromsResult {
+	L := pile.Length
+	d := pile.Diameter
+	My := pile.YieldMoment
+	layer := soilProfile.Layers[soilProfile.GetLayerIndex(L)]
+
+	if isCohesive(layer) {
+		cu := layer.UndrainedShearStrength
+		return BromsResult{
+			SoilType: "cohesive",
+			Free:     calcBromsCohesiveFree(cu, d, L, eccentricity, My),
+			Fixed:    calcBromsCohesiveFixed(cu, d, L, My),
+		}
+	}
+
+	gamma := soilProfile.CalcEffectiveStress(L) / L
+	Kp := math.Pow(math.Tan(pkg.Radian(45+layer.EffectiveFrictionAngle/2)), 2)
+	return BromsResult{
+		SoilType: "cohesionless",
+		Free:     calcBromsCohesionlessFree(gamma, Kp, d, L, eccentricity, My),
+		Fixed:    calcBromsCohesionlessFixed(gamma, Kp, d, L, My),
+	}
+}
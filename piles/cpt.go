@@ -0,0 +1,189 @@
+package piles
+
+import (
+	"math"
+
+	pkg "github.com/geoport/GeoGo/internal"
+	"github.com/geoport/GeoGo/models"
+)
+
+// calcLCPCShaftParams returns the friction coefficient α and the limit skin friction (in t/m2) of the LCPC method
+// (Bustamante & Gianeselli, 1982).
+func calcLCPCShaftParams(isCohesive, isBored bool, qc float64) (float64, float64) {
+	pick := func(bored, driven float64) float64 {
+		if isBored {
+			return bored
+		}
+		return driven
+	}
+	switch {
+	case qc < 1*pkg.MPa:
+		return 30, 1.5
+	case isCohesive && qc < 5*pkg.MPa:
+		return pick(40, 80), 3.5
+	case isCohesive:
+		return pick(60, 120), 3.5
+	case qc < 5*pkg.MPa:
+		return pick(60, 150), 3.5
+	case qc < 12*pkg.MPa:
+		return pick(100, 200), 8
+	default:
+		return pick(150, 200), 12
+	}
+}
+
+// calcLCPCKc returns the bearing factor kc of the LCPC method.
+func calcLCPCKc(isCohesive, isBored bool) float64 {
+	switch {
+	case isCohesive && isBored:
+		return 0.375
+	case isCohesive:
+		return 0.6
+	case isBored:
+		return 0.15
+	default:
+		return 0.375
+	}
+}
+
+// calcLCPC calculates the capacity by Bustamante & Gianeselli (1982). The equivalent tip cone resistance is the
+// average between 1.5D above and 1.5D below the tip after clipping the values to 0.7 - 1.3 times the average.
+func calcLCPC(soilProfile models.SoilProfile, pile models.Pile, cptData models.CPT) FieldTestCapacity {
+	L := pile.Length
+	D := pile.Diameter
+	isBored := pile.Type == "bored"
+	perimeter := calcPerimeter(pile)
+
+	var shaftResistance, qcSum float64
+	exps, thicknesses := cptData.GetSegments(0, L)
+	for i, exp := range exps {
+		layer := soilProfile.Layers[soilProfile.GetLayerIndex(exp.Depth)]
+		alpha, fmax := calcLCPCShaftParams(layer.IsCohesive, isBored, exp.ConeResistance)
+		fs := math.Min(exp.ConeResistance/alpha, fmax)
+		shaftResistance += fs * perimeter * thicknesses[i]
+		qcSum += exp.ConeResistance * thicknesses[i]
+	}
+
+	top := L - 1.5*D
+	bottom := L + 1.5*D
+	tipExps, tipThicknesses := cptData.GetSegments(top, bottom)
+	var qca, total float64
+	for i, exp := range tipExps {
+		qca += exp.ConeResistance * tipThicknesses[i]
+		total += tipThicknesses[i]
+	}
+	if total > 0 {
+		qca /= total
+	}
+	var qeq float64
+	for i, exp := range tipExps {
+		qeq += math.Min(math.Max(exp.ConeResistance, 0.7*qca), 1.3*qca) * tipThicknesses[i]
+	}
+	if total > 0 {
+		qeq /= total
+	}
+
+	tipLayer := soilProfile.Layers[soilProfile.GetLayerIndex(L)]
+	qp := calcLCPCKc(tipLayer.IsCohesive, isBored) * qeq
+
+	capacity := newFieldTestCapacity(pile, shaftResistance, qp)
+	capacity.TipZoneTop = top
+	capacity.TipZoneBottom = bottom
+	capacity.AverageShaftValue = qcSum / L
+	capacity.AverageTipValue = qeq
+
+	return capacity
+}
+
+// getEslamiCs returns the shaft correlation coefficient of Eslami & Fellenius (1997) for the soil category of the
+// layer and the effective cone resistance.
+func getEslamiCs(soilCategory string, qE float64) float64 {
+	switch soilCategory {
+	case "clay":
+		if qE < 1*pkg.MPa {
+			return 0.08
+		} else if qE < 2*pkg.MPa {
+			return 0.05
+		}
+		return 0.025
+	case "silt":
+		return 0.025
+	case "sandy silt":
+		return 0.01
+	default:
+		return 0.004
+	}
+}
+
+// calcGeometricMean returns the thickness weighted geometric average of the effective cone resistances.
+func calcGeometricMean(exps []models.CptExp, thicknesses []float64) float64 {
+	var sum, total float64
+	for i, exp := range exps {
+		qE := math.Max(exp.ConeResistance-exp.PorePressure, 1e-3)
+		sum += math.Log(qE) * thicknesses[i]
+		total += thicknesses[i]
+	}
+	if total == 0 {
+		return 0
+	}
+	return math.Exp(sum / total)
+}
+
+// calcEslamiFellenius calculates the capacity by Eslami & Fellenius (1997) using the effective cone resistance
+// qE = qc - u. The tip zone extends 4D below the tip and 8D above it when the pile enters a stronger layer from a
+// weaker one, or 2D above it otherwise.
+func calcEslamiFellenius(soilProfile models.SoilProfile, pile models.Pile, cptData models.CPT) FieldTestCapacity {
+	L := pile.Length
+	D := pile.Diameter
+	perimeter := calcPerimeter(pile)
+
+	var shaftResistance, qESum float64
+	exps, thicknesses := cptData.GetSegments(0, L)
+	for i, exp := range exps {
+		layer := soilProfile.Layers[soilProfile.GetLayerIndex(exp.Depth)]
+		qE := math.Max(exp.ConeResistance-exp.PorePressure, 0)
+		shaftResistance += getEslamiCs(getSoilCategory(layer), qE) * qE * perimeter * thicknesses[i]
+		qESum += qE * thicknesses[i]
+	}
+
+	top := L - 2*D
+	bottom := L + 4*D
+	above := calcGeometricMean(cptData.GetSegments(L-8*D, L))
+	below := calcGeometricMean(cptData.GetSegments(L, bottom))
+	if above < below {
+		top = L - 8*D
+	}
+	qEg := calcGeometricMean(cptData.GetSegments(top, bottom))
+
+	Ct := math.Min(1, 1/(3*D))
+
+	capacity := newFieldTestCapacity(pile, shaftResistance, Ct*qEg)
+	capacity.TipZoneTop = top
+	capacity.TipZoneBottom = bottom
+	capacity.AverageShaftValue = qESum / L
+	capacity.AverageTipValue = qEg
+
+	return capacity
+}
+
+// CalcCPTCapacity calculates the axial compressive capacity of a single pile from CPT experiments with the LCPC
+// method of Bustamante & Gianeselli (1982) and the method of Eslami & Fellenius (1997).
+//
+// Parameters:
+//
+// - soilProfile (models.SoilProfile): The soil profile. The soil class of each layer selects the correlation
+// coefficients.
+//
+// - pile (models.Pile): The pile data.
+//
+// - cptData (models.CPT): CPT experiments. Cone resistance and pore pressure are in t/m2.
+//
+// Returns:
+//
+// - result (CPTResult)
+func CalcCPTCapacity(soilProfile models.SoilProfile, pile models.Pile, cptData models.CPT) CPTResult {
+	return CPTResult{
+		LCPC:            calcLCPC(soilProfile, pile, cptData),
+		EslamiFellenius: calcEslamiFellenius(soilProfile, pile, cptData),
+	}
+}
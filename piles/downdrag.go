@@ -0,0 +1,169 @@
+package piles
+
+import (
+	"math"
+
+	pkg "github.com/geoport/GeoGo/internal"
+	"github.com/geoport/GeoGo/models"
+)
+
+// number of segments of the pile in the downdrag analysis
+const downdragSegments = 100
+
+// maximum thickness (in meters) of the sublayers of the soil settlement profile
+const settlementSublayer = 0.5
+
+// toe movement of the ultimate tip resistance relative to the pile diameter
+const toeReferenceMovement = 0.1
+
+// exponent of the ratio function of the toe load-movement curve (Gwizdala, 1996)
+const toeExponent = 0.5
+
+// maximum number of iterations of the settlement equilibrium
+const maxDowndragIterations = 50
+
+// calcUnitSkinFriction returns the unit shaft resistance at the given depth by the α or β method.
+func calcUnitSkinFriction(soilProfile models.SoilProfile, pile models.Pile, z float64) float64 {
+	layer := soilProfile.Layers[soilProfile.GetLayerIndex(z)]
+	effectiveStress := soilProfile.CalcEffectiveStress(z)
+	if isCohesive(layer) {
+		return calcAlpha(pile, layer.UndrainedShearStrength, math.Max(effectiveStress, 1e-3)) *
+			layer.UndrainedShearStrength
+	}
+	return calcBeta(pile, layer.EffectiveFrictionAngle) * effectiveStress
+}
+
+// calcSoilSettlementProfile returns the depths and the settlements (in meters) of the soil under a uniform surcharge
+// of infinite extent. The settlement at a depth is the compression of the soil below it.
+func calcSoilSettlementProfile(soilProfile models.SoilProfile, surcharge float64) ([]float64, []float64) {
+	depths := []float64{0}
+	var compressions []float64
+
+	var top float64
+	for _, layer := range soilProfile.Layers {
+		for top < layer.Depth-1e-9 {
+			bottom := math.Min(top+settlementSublayer, layer.Depth)
+			effectiveStress := math.Max(soilProfile.CalcEffectiveStress((top+bottom)/2), 1e-3)
+			s, _ := calcLayerSettlement(layer, bottom-top, effectiveStress, surcharge)
+			compressions = append(compressions, s)
+			depths = append(depths, bottom)
+			top = bottom
+		}
+	}
+
+	settlements := make([]float64, len(depths))
+	for i := len(compressions) - 1; i >= 0; i-- {
+		settlements[i] = settlements[i+1] + compressions[i]
+	}
+	return depths, settlements
+}
+
+// calcShortening returns the elastic shortening of the pile between the given nodes for the axial forces.
+func calcShortening(forces []float64, h, EA float64, first, last int) float64 {
+	var shortening float64
+	for i := first; i < last; i++ {
+		shortening += (forces[i] + forces[i+1]) / 2 * h / EA
+	}
+	return shortening
+}
+
+// CalcDowndrag calculates the neutral plane, dragload and downdrag of a single pile in a consolidating soil by
+// Fellenius' unified method. The neutral plane is where the load from the head, including the negative skin
+// friction, equals the resistance from the toe. The toe resistance is mobilized by the toe penetration, the toe
+// settlement minus the soil settlement at the toe, with a ratio function and the force and settlement equilibriums
+// are iterated.
+//
+// Parameters:
+//
+// - soilProfile (models.SoilProfile): The soil profile. The consolidation settlement is calculated with the volume
+// compressibility coefficient, the compression indexes or the elastic modulus of the layers.
+//
+// - pile (models.Pile): The pile data.
+//
+// - deadLoad (float64): Sustained load on the pile head (in tons).
+//
+// - surcharge (float64): Uniform fill or surcharge causing the consolidation (in t/m2).
+//
+// Returns:
+//
+// - result (DowndragResult)
+func CalcDowndrag(soilProfile models.SoilProfile, pile models.Pile, deadLoad, surcharge float64) DowndragResult {
+	L := pile.Length
+	h := L / downdragSegments
+	EA := pile.ElasticModulus * calcTipArea(pile)
+	perimeter := calcPerimeter(pile)
+	ultimateTip := calcAlphaBeta(soilProfile, pile).TipResistance
+	settlementDepths, settlementProfile := calcSoilSettlementProfile(soilProfile, surcharge)
+
+	n := downdragSegments + 1
+	result := DowndragResult{
+		Depths:          make([]float64, n),
+		SoilSettlements: make([]float64, n),
+		LoadCurve:       make([]float64, n),
+		ResistanceCurve: make([]float64, n),
+		AxialForces:     make([]float64, n),
+	}
+
+	// cumulative shaft resistance from the head
+	shaft := make([]float64, n)
+	soilSettlements := make([]float64, n)
+	for i := range result.Depths {
+		z := float64(i) * h
+		result.Depths[i] = z
+		soilSettlements[i] = pkg.Interp(z, settlementDepths, settlementProfile)
+		if i > 0 {
+			fs := (calcUnitSkinFriction(soilProfile, pile, z-h) + calcUnitSkinFriction(soilProfile, pile, z)) / 2
+			shaft[i] = shaft[i-1] + fs*perimeter*h
+		}
+	}
+	totalShaft := shaft[n-1]
+
+	toeResistance := ultimateTip
+	var neutralPlane, previous float64
+	var neutralIndex int
+	for result.Iterations < maxDowndragIterations {
+		result.Iterations++
+
+		neutralIndex = n - 1
+		for i := range shaft {
+			result.LoadCurve[i] = deadLoad + shaft[i]
+			result.ResistanceCurve[i] = toeResistance + totalShaft - shaft[i]
+		}
+		for i := 1; i < n; i++ {
+			if result.LoadCurve[i] >= result.ResistanceCurve[i] {
+				neutralIndex = i
+				break
+			}
+		}
+		if result.LoadCurve[0] >= result.ResistanceCurve[0] {
+			neutralIndex = 0
+		}
+		neutralPlane = result.Depths[neutralIndex]
+
+		for i := range result.AxialForces {
+			result.AxialForces[i] = math.Min(result.LoadCurve[i], result.ResistanceCurve[i])
+		}
+		toeSettlement := soilSettlements[neutralIndex] - calcShortening(result.AxialForces, h, EA, neutralIndex, n-1)
+		toeMovement := toeSettlement - soilSettlements[n-1]
+		mobilized := ultimateTip * math.Min(math.Pow(math.Max(toeMovement, 0)/(toeReferenceMovement*pile.Diameter), toeExponent), 1)
+		result.ToeMovement = toeMovement * 100
+
+		if result.Iterations > 1 && math.Abs(neutralPlane-previous) < 1e-9 && math.Abs(mobilized-toeResistance) < 1e-3 {
+			break
+		}
+		previous = neutralPlane
+		toeResistance = (toeResistance + mobilized) / 2
+	}
+
+	for i := range soilSettlements {
+		result.SoilSettlements[i] = soilSettlements[i] * 100
+	}
+	result.NeutralPlaneDepth = neutralPlane
+	result.ToeResistance = toeResistance
+	result.Dragload = shaft[neutralIndex]
+	result.MaxAxialForce = deadLoad + shaft[neutralIndex]
+	result.DowndragSettlement = (soilSettlements[neutralIndex] +
+		calcShortening(result.AxialForces, h, EA, 0, neutralIndex)) * 100
+
+	return result
+}
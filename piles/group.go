@@ -0,0 +1,198 @@
+package piles
+
+import (
+	"math"
+
+	pkg "github.com/geoport/GeoGo/internal"
+	"github.com/geoport/GeoGo/models"
+)
+
+// maximum thickness of the sublayers used in the group settlement
+const sublayerThickness = 1.
+
+// calcGroupDimensions returns the outer width and length of the pile group.
+func calcGroupDimensions(pile models.Pile, group models.PileGroup) (float64, float64) {
+	width := float64(group.Columns-1)*group.Spacing + pile.Diameter
+	length := float64(group.Rows-1)*group.Spacing + pile.Diameter
+	return width, length
+}
+
+// calcConverseLabarre returns the group efficiency by the Converse-Labarre formula.
+func calcConverseLabarre(pile models.Pile, group models.PileGroup) float64 {
+	m := float64(group.Rows)
+	n := float64(group.Columns)
+	theta := math.Atan(pile.Diameter/group.Spacing) * 180 / math.Pi
+	return 1 - theta*((n-1)*m+(m-1)*n)/(90*m*n)
+}
+
+// calcFeld returns the group efficiency by Feld's rule, which reduces the capacity of each pile by 1/16 for every
+// adjacent pile in straight and diagonal rows.
+func calcFeld(group models.PileGroup) float64 {
+	var total float64
+	for i := 0; i < group.Rows; i++ {
+		for j := 0; j < group.Columns; j++ {
+			var neighbours float64
+			for di := -1; di <= 1; di++ {
+				for dj := -1; dj <= 1; dj++ {
+					r, c := i+di, j+dj
+					if (di != 0 || dj != 0) && r >= 0 && r < group.Rows && c >= 0 && c < group.Columns {
+						neighbours++
+					}
+				}
+			}
+			total += 1 - neighbours/16
+		}
+	}
+	return total / float64(group.Rows*group.Columns)
+}
+
+// calcBlockCapacity returns the ultimate capacity of the block enclosing the pile group and the bearing factor
+// Nc = 5(1 + 0.2·L/B)(1 + 0.2·B/L) of Skempton (1951) limited to 9. Cohesive layers resist with their undrained shear
+// strength, granular layers with soil to soil friction K0·σ'v·tanφ' along the block perimeter. The tip resistance is
+// Nc·Su in cohesive layers and Nq·σ'v in granular layers.
+func calcBlockCapacity(soilProfile models.SoilProfile, pile models.Pile, width, length float64) (float64, float64) {
+	perimeter := 2 * (width + length)
+
+	var shaftResistance float64
+	for _, layer := range getPileLayers(soilProfile, pile) {
+		if isCohesive(layer) {
+			shaftResistance += perimeter * layer.Thickness * layer.UndrainedShearStrength
+		} else {
+			phi := pkg.Radian(layer.EffectiveFrictionAngle)
+			beta := (1 - math.Sin(phi)) * math.Tan(phi)
+			shaftResistance += perimeter * layer.Thickness * beta * soilProfile.CalcEffectiveStress(layer.Center)
+		}
+	}
+
+	B := math.Min(width, length)
+	L := math.Max(width, length)
+	Nc := math.Min(5*(1+0.2*math.Min(pile.Length/B, 2.5))*(1+0.2*B/L), 9)
+
+	tipLayer := soilProfile.Layers[soilProfile.GetLayerIndex(pile.Length)]
+	var unitTipResistance float64
+	if isCohesive(tipLayer) {
+		unitTipResistance = Nc * tipLayer.UndrainedShearStrength
+	} else {
+		unitTipResistance = calcJanbuNq(tipLayer.EffectiveFrictionAngle) * soilProfile.CalcEffectiveStress(pile.Length)
+	}
+
+	return shaftResistance + unitTipResistance*width*length, Nc
+}
+
+// calcLayerSettlement returns the settlement (in meters) of a sublayer and the method used. The volume
+// compressibility coefficient is used if given, then the compression indexes and finally the elastic modulus.
+func calcLayerSettlement(layer models.SoilLayer, H, effectiveStress, stressIncrease float64) (float64, string) {
+	switch {
+	case layer.VolumeCompressibilityCoefficient > 0:
+		return layer.VolumeCompressibilityCoefficient * stressIncrease * H, "mv"
+	case layer.CompressionIndex > 0:
+		finalStress := effectiveStress + stressIncrease
+		factor := H / (1 + layer.VoidRatio)
+		sigmaP := layer.PreconsolidationPressure
+		if sigmaP <= effectiveStress {
+			return factor * layer.CompressionIndex * math.Log10(finalStress/effectiveStress), "Cc"
+		}
+		if finalStress <= sigmaP {
+			return factor * layer.RecompressionIndex * math.Log10(finalStress/effectiveStress), "Cc"
+		}
+		return factor * (layer.RecompressionIndex*math.Log10(sigmaP/effectiveStress) +
+			layer.CompressionIndex*math.Log10(finalStress/sigmaP)), "Cc"
+	case layer.ElasticModulus > 0:
+		return stressIncrease * H / layer.ElasticModulus, "elastic"
+	default:
+		return 0, ""
+	}
+}
+
+// calcGroupSettlement calculates the settlement of the pile group with an equivalent raft at 2/3 of the pile length.
+// The raft pressure spreads with 2V:1H below the raft and the sublayers are summed until the stress increase drops
+// below 10% of the effective stress.
+func calcGroupSettlement(
+	soilProfile models.SoilProfile, pile models.Pile, width, length, load float64,
+) GroupSettlement {
+	raftDepth := 2 * pile.Length / 3
+	pressure := load / (width * length)
+	result := GroupSettlement{RaftDepth: raftDepth, RaftPressure: pressure}
+
+	profileDepth := soilProfile.Layers[len(soilProfile.Layers)-1].Depth
+	var settlement float64
+	top := raftDepth
+	for top < profileDepth {
+		layer := soilProfile.Layers[soilProfile.GetLayerIndex(top+1e-6)]
+		bottom := math.Min(math.Min(top+sublayerThickness, layer.Depth), profileDepth)
+		center := (top + bottom) / 2
+		z := center - raftDepth
+
+		effectiveStress := soilProfile.CalcEffectiveStress(center)
+		stressIncrease := load / ((width + z) * (length + z))
+		if stressIncrease < 0.1*effectiveStress {
+			break
+		}
+
+		s, method := calcLayerSettlement(layer, bottom-top, effectiveStress, stressIncrease)
+		result.Layers = append(result.Layers, SettlementLayer{
+			Top:             top,
+			Bottom:          bottom,
+			EffectiveStress: effectiveStress,
+			StressIncrease:  stressIncrease,
+			Method:          method,
+			Settlement:      s * 100,
+		})
+		settlement += s
+		top = bottom
+	}
+	result.Settlement = settlement * 100
+
+	return result
+}
+
+// CalcGroupCapacity calculates the capacity and settlement of a rectangular pile group.
+//
+// Parameters:
+//
+// - soilProfile (models.SoilProfile): The soil profile to be analyzed.
+//
+// - pile (models.Pile): The data of a single pile.
+//
+// - group (models.PileGroup): Layout of the group.
+//
+// - load (float64): Total vertical load on the group (in tons).
+//
+// Returns:
+//
+// - result (GroupResult): The single pile capacity is taken from the α and β methods. The ultimate capacity is the
+// minimum of the Converse-Labarre, Feld and block capacities.
+func CalcGroupCapacity(
+	soilProfile models.SoilProfile, pile models.Pile, group models.PileGroup, load float64,
+) GroupResult {
+	pileCount := group.Rows * group.Columns
+	width, length := calcGroupDimensions(pile, group)
+	single := calcAlphaBeta(soilProfile, pile).UltimateCapacity
+
+	converseLabarre := calcConverseLabarre(pile, group)
+	feld := calcFeld(group)
+	blockCapacity, Nc := calcBlockCapacity(soilProfile, pile, width, length)
+
+	result := GroupResult{
+		PileCount:          pileCount,
+		Width:              width,
+		Length:             length,
+		SinglePileCapacity: single,
+		ConverseLabarre: GroupEfficiency{
+			Efficiency: converseLabarre,
+			Capacity:   converseLabarre * float64(pileCount) * single,
+		},
+		Feld: GroupEfficiency{
+			Efficiency: feld,
+			Capacity:   feld * float64(pileCount) * single,
+		},
+		BlockCapacity: blockCapacity,
+		BlockNc:       Nc,
+		Settlement:    calcGroupSettlement(soilProfile, pile, width, length, load),
+	}
+	result.UltimateCapacity = math.Min(math.Min(result.ConverseLabarre.Capacity, result.Feld.Capacity), blockCapacity)
+	result.AllowableLoad = result.UltimateCapacity / safetyFactor
+	result.IsSafe = result.AllowableLoad >= load
+
+	return result
+}
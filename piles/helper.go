@@ -0,0 +1,117 @@
+package piles
+
+import (
+	"math"
+	"strings"
+
+	pkg "github.com/geoport/GeoGo/internal"
+	"github.com/geoport/GeoGo/models"
+)
+
+// isCohesive returns true if the layer is analyzed in undrained conditions, i.e. it is cohesive and its undrained
+// shear strength is given.
+func isCohesive(layer models.SoilLayer) bool {
+	return layer.IsCohesive && layer.UndrainedShearStrength > 0
+}
+
+// calcPerimeter returns the perimeter of the pile.
+func calcPerimeter(pile models.Pile) float64 {
+	return math.Pi * pile.Diameter
+}
+
+// calcTipArea returns the cross-sectional area of the pile tip.
+func calcTipArea(pile models.Pile) float64 {
+	return math.Pi * math.Pow(pile.Diameter, 2) / 4
+}
+
+// getFrictionRatio returns the ratio of the pile-soil interface friction angle to the effective friction angle of
+// the soil (Kulhawy, 1984).
+func getFrictionRatio(pile models.Pile) float64 {
+	switch pile.Material {
+	case "steel":
+		return 0.7
+	case "timber":
+		return 0.8
+	default:
+		if pile.Type == "bored" {
+			return 1
+		}
+		return 0.8
+	}
+}
+
+// getEarthPressureRatio returns the ratio of the lateral earth pressure coefficient along the shaft to the at-rest
+// coefficient (Kulhawy, 1984).
+func getEarthPressureRatio(pile models.Pile) float64 {
+	if pile.Type == "bored" {
+		return 1
+	}
+	return 1.5
+}
+
+// getPileLayers returns the layers of the soil profile along the pile shaft.
+func getPileLayers(soilProfile models.SoilProfile, pile models.Pile) []models.SoilLayer {
+	slicedProfile := soilProfile.SliceProfile(0, pile.Length)
+	var layers []models.SoilLayer
+	for _, layer := range slicedProfile.Layers {
+		if layer.Thickness > 0 {
+			layers = append(layers, layer)
+		}
+	}
+	return layers
+}
+
+// calcJanbuNq returns Janbu's (1976) end bearing factor for a plastification angle of 90 degrees.
+func calcJanbuNq(phi float64) float64 {
+	tanPhi := math.Tan(pkg.Radian(phi))
+	return math.Pow(tanPhi+math.Sqrt(1+tanPhi*tanPhi), 2) * math.Exp(math.Pi*tanPhi)
+}
+
+// sumCapacity fills the total shaft resistance, tip resistance and allowable load of the capacity.
+func sumCapacity(capacity Capacity, tipArea float64) Capacity {
+	for _, layer := range capacity.Layers {
+		capacity.ShaftResistance += layer.ShaftResistance
+	}
+	capacity.TipResistance = capacity.UnitTipResistance * tipArea
+	capacity.UltimateCapacity = capacity.ShaftResistance + capacity.TipResistance
+	capacity.SafetyFactor = safetyFactor
+	capacity.AllowableLoad = capacity.UltimateCapacity / safetyFactor
+	return capacity
+}
+
+// getSoilCategory returns the soil category of the layer used by the field test methods: "clay", "silt",
+// "sandy silt" or "sand".
+func getSoilCategory(layer models.SoilLayer) string {
+	soilClass := strings.ToUpper(layer.SoilClass)
+	switch {
+	case strings.HasPrefix(soilClass, "C"), strings.HasPrefix(soilClass, "O"), soilClass == "PT":
+		return "clay"
+	case strings.HasPrefix(soilClass, "M"):
+		return "silt"
+	case strings.HasPrefix(soilClass, "SM"), strings.HasPrefix(soilClass, "SC"):
+		return "sandy silt"
+	case soilClass == "":
+		if layer.IsCohesive {
+			return "clay"
+		}
+		return "sand"
+	default:
+		return "sand"
+	}
+}
+
+// newFieldTestCapacity returns the capacity of the field test methods from the total shaft resistance and the unit
+// tip resistance.
+func newFieldTestCapacity(pile models.Pile, shaftResistance, unitTipResistance float64) FieldTestCapacity {
+	tipResistance := unitTipResistance * calcTipArea(pile)
+	ultimateCapacity := shaftResistance + tipResistance
+	return FieldTestCapacity{
+		UnitSkinFriction:  shaftResistance / (calcPerimeter(pile) * pile.Length),
+		UnitTipResistance: unitTipResistance,
+		ShaftResistance:   shaftResistance,
+		TipResistance:     tipResistance,
+		UltimateCapacity:  ultimateCapacity,
+		SafetyFactor:      safetyFactor,
+		AllowableLoad:     ultimateCapacity / safetyFactor,
+	}
+}
@@ -0,0 +1,162 @@
+package piles
+
+import (
+	"math"
+
+	pkg "github.com/geoport/GeoGo/internal"
+	"github.com/geoport/GeoGo/models"
+)
+
+// number of segments of the pile in the finite difference solution
+const lateralSegments = 100
+
+// maximum number of iterations of the secant stiffness of the springs
+const maxLateralIterations = 100
+
+// tolerance of the change in deflections (in meters) between iterations
+const lateralTolerance = 1e-7
+
+// smallest deflection (in meters) used to calculate the secant stiffness of the springs
+const minDeflection = 1e-6
+
+// calcMomentOfInertia returns the moment of inertia of the solid circular pile section.
+func calcMomentOfInertia(pile models.Pile) float64 {
+	return math.Pi * math.Pow(pile.Diameter, 4) / 64
+}
+
+// calcSecantStiffness returns the secant stiffness (in t/m2) of the p-y curve at the deflection.
+func calcSecantStiffness(curve pyCurve, y float64) float64 {
+	y = math.Max(math.Abs(y), minDeflection)
+	return curve.P(y) / y
+}
+
+// solveBeam solves the finite difference equations of the beam on springs. The deflections of the two imaginary
+// nodes above the head and below the tip are included at both ends of the returned slice.
+func solveBeam(EI, h float64, springs []float64, load, moment float64, isFixed bool) []float64 {
+	n := len(springs) - 1
+	size := n + 5
+	A := make([][]float64, size)
+	for i := range A {
+		A[i] = make([]float64, size)
+	}
+	b := make([]float64, size)
+	h4 := math.Pow(h, 4)
+
+	// node i of the pile is the unknown i+2
+	for i := 0; i <= n; i++ {
+		row := i + 2
+		A[row][row-2] = 1
+		A[row][row-1] = -4
+		A[row][row] = 6 + springs[i]*h4/EI
+		A[row][row+1] = -4
+		A[row][row+2] = 1
+	}
+
+	// head: EI·y''' = load and either EI·y'' = moment or y' = 0
+	A[0][0], A[0][1], A[0][3], A[0][4] = -1, 2, -2, 1
+	b[0] = 2 * math.Pow(h, 3) * load / EI
+	if isFixed {
+		A[1][1], A[1][3] = -1, 1
+	} else {
+		A[1][1], A[1][2], A[1][3] = 1, -2, 1
+		b[1] = h * h * moment / EI
+	}
+
+	// tip: zero moment and zero shear
+	A[size-2][n+1], A[size-2][n+2], A[size-2][n+3] = 1, -2, 1
+	A[size-1][n], A[size-1][n+1], A[size-1][n+3], A[size-1][n+4] = -1, 2, -2, 1
+
+	return pkg.SolveLinear(A, b)
+}
+
+// sampleCurve returns the points of the p-y curve up to the given deflection.
+func sampleCurve(curve pyCurve, depth, maxY float64) PYCurve {
+	result := PYCurve{Depth: depth, Type: curve.Type}
+	for i := 0; i <= 20; i++ {
+		y := maxY * float64(i) / 20
+		result.Y = append(result.Y, y)
+		result.P = append(result.P, curve.P(y))
+	}
+	return result
+}
+
+// CalcLateralResponse calculates the response of a laterally loaded single pile with nonlinear p-y springs by the
+// finite difference method. The secant stiffness of the springs is iterated until the deflections converge.
+//
+// Parameters:
+//
+// - soilProfile (models.SoilProfile): The soil profile. Cohesive layers with undrained shear strength use Matlock's
+// soft clay or Reese's stiff clay p-y curves, the others API sand curves.
+//
+// - pile (models.Pile): The pile data. The pile head is at the ground surface.
+//
+// - load (float64): Lateral load at the pile head (in tons).
+//
+// - moment (float64): Moment at the pile head (in t.m), ignored for fixed head piles.
+//
+// - headCondition (string): "free" or "fixed" (no rotation).
+//
+// Returns:
+//
+// - result (LateralResult)
+func CalcLateralResponse(
+	soilProfile models.SoilProfile, pile models.Pile, load, moment float64, headCondition string,
+) LateralResult {
+	isFixed := headCondition == "fixed"
+	EI := pile.ElasticModulus * calcMomentOfInertia(pile)
+	h := pile.Length / lateralSegments
+
+	depths := make([]float64, lateralSegments+1)
+	curves := make([]pyCurve, lateralSegments+1)
+	springs := make([]float64, lateralSegments+1)
+	for i := range depths {
+		depths[i] = float64(i) * h
+		curves[i] = getPYCurve(soilProfile, pile, depths[i])
+		springs[i] = calcSecantStiffness(curves[i], minDeflection)
+	}
+
+	var y []float64
+	result := LateralResult{HeadCondition: headCondition, Depths: depths}
+	for result.Iterations < maxLateralIterations {
+		result.Iterations++
+		newY := solveBeam(EI, h, springs, load, moment, isFixed)
+
+		var change float64
+		for i := range newY {
+			if y != nil {
+				change = math.Max(change, math.Abs(newY[i]-y[i]))
+			}
+		}
+		y = newY
+		for i := range springs {
+			springs[i] = calcSecantStiffness(curves[i], y[i+2])
+		}
+		if result.Iterations > 1 && change < lateralTolerance {
+			result.IsConverged = true
+			break
+		}
+	}
+
+	for i := range depths {
+		j := i + 2
+		result.Deflections = append(result.Deflections, y[j])
+		result.Rotations = append(result.Rotations, (y[j+1]-y[j-1])/(2*h))
+		result.Moments = append(result.Moments, EI*(y[j-1]-2*y[j]+y[j+1])/(h*h))
+		result.Shears = append(result.Shears, EI*(y[j+2]-2*y[j+1]+2*y[j-1]-y[j-2])/(2*math.Pow(h, 3)))
+		result.SoilReactions = append(result.SoilReactions, math.Copysign(curves[i].P(math.Abs(y[j])), y[j]))
+
+		if math.Abs(result.Moments[i]) > math.Abs(result.MaxMoment) {
+			result.MaxMoment = result.Moments[i]
+			result.MaxMomentDepth = depths[i]
+		}
+	}
+	result.HeadDeflection = result.Deflections[0]
+	result.HeadRotation = result.Rotations[0]
+
+	maxY := math.Max(math.Abs(result.HeadDeflection), 10*minDeflection)
+	for _, layer := range getPileLayers(soilProfile, pile) {
+		result.Curves = append(result.Curves, sampleCurve(getPYCurve(soilProfile, pile, layer.Center), layer.Center, maxY))
+	}
+
+	return result
+}
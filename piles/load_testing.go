@@ -0,0 +1,180 @@
+package piles
+
+import (
+	"math"
+
+	pkg "github.com/geoport/GeoGo/internal"
+	"github.com/geoport/GeoGo/models"
+)
+
+// getPositiveReadings returns the readings with positive load and settlement.
+func getPositiveReadings(test models.PileLoadTest) []models.PileLoadReading {
+	var readings []models.PileLoadReading
+	for _, reading := range test.Readings {
+		if reading.Load > 0 && reading.Settlement > 0 {
+			readings = append(readings, reading)
+		}
+	}
+	return readings
+}
+
+// interpretDavisson returns the capacity where the load-settlement curve crosses the offset line of Davisson (1972),
+// which is parallel to the elastic compression line with an offset of 0.38 cm + D/120.
+func interpretDavisson(pile models.Pile, test models.PileLoadTest) LoadTestInterpretation {
+	// elastic compression of the pile in cm per ton
+	compliance := pile.Length / (calcTipArea(pile) * pile.ElasticModulus) * 100
+	offset := 0.38 + pile.Diameter*100/120
+
+	var result LoadTestInterpretation
+	var previous float64
+	for i, reading := range test.Readings {
+		line := offset + compliance*reading.Load
+		result.Loads = append(result.Loads, reading.Load)
+		result.Settlements = append(result.Settlements, line)
+
+		diff := reading.Settlement - line
+		if i > 0 && !result.IsFound && previous < 0 && diff >= 0 {
+			prev := test.Readings[i-1]
+			ratio := -previous / (diff - previous)
+			result.UltimateCapacity = prev.Load + ratio*(reading.Load-prev.Load)
+			result.Settlement = prev.Settlement + ratio*(reading.Settlement-prev.Settlement)
+			result.IsFound = true
+		}
+		previous = diff
+	}
+	return result
+}
+
+// interpretChinKondner returns the asymptotic capacity of the hyperbola s/Q = C1·s + C2 fitted to the readings
+// (Chin, 1970).
+func interpretChinKondner(test models.PileLoadTest) LoadTestInterpretation {
+	var result LoadTestInterpretation
+	readings := getPositiveReadings(test)
+	if len(readings) < 2 {
+		return result
+	}
+
+	var x, y []float64
+	for _, reading := range readings {
+		x = append(x, reading.Settlement)
+		y = append(y, reading.Settlement/reading.Load)
+	}
+	C1, C2 := pkg.LinearFit(x, y)
+	if C1 <= 0 {
+		return result
+	}
+
+	result.UltimateCapacity = 1 / C1
+	result.IsFound = true
+	for _, s := range x {
+		result.Loads = append(result.Loads, s/(C1*s+C2))
+		result.Settlements = append(result.Settlements, s)
+	}
+	return result
+}
+
+// interpretBrinchHansen returns the capacity of Brinch Hansen's (1963) 80% criterion from the line
+// √s/Q = C1·s + C2 fitted to the readings. At the ultimate load the settlement is four times the settlement at 80% of
+// the ultimate load.
+func interpretBrinchHansen(test models.PileLoadTest) LoadTestInterpretation {
+	var result LoadTestInterpretation
+	readings := getPositiveReadings(test)
+	if len(readings) < 2 {
+		return result
+	}
+
+	var x, y []float64
+	for _, reading := range readings {
+		x = append(x, reading.Settlement)
+		y = append(y, math.Sqrt(reading.Settlement)/reading.Load)
+	}
+	C1, C2 := pkg.LinearFit(x, y)
+	if C1 <= 0 || C2 <= 0 {
+		return result
+	}
+
+	result.UltimateCapacity = 1 / (2 * math.Sqrt(C1*C2))
+	result.Settlement = C2 / C1
+	result.IsFound = true
+	for _, s := range x {
+		result.Loads = append(result.Loads, math.Sqrt(s)/(C1*s+C2))
+		result.Settlements = append(result.Settlements, s)
+	}
+	return result
+}
+
+// calcSquaredError returns the sum of squared residuals of the line fitted to the points.
+func calcSquaredError(x, y []float64) (float64, float64, float64) {
+	slope, intercept := pkg.LinearFit(x, y)
+	var sum float64
+	for i := range x {
+		sum += math.Pow(y[i]-slope*x[i]-intercept, 2)
+	}
+	return sum, slope, intercept
+}
+
+// interpretDeBeer returns the yield load of De Beer (1968) as the intersection of the two lines fitted to the
+// readings on the log load - log settlement plot. The break point minimizes the total squared error of the lines.
+func interpretDeBeer(test models.PileLoadTest) LoadTestInterpretation {
+	var result LoadTestInterpretation
+	readings := getPositiveReadings(test)
+	if len(readings) < 4 {
+		return result
+	}
+
+	var logS, logQ []float64
+	for _, reading := range readings {
+		logS = append(logS, math.Log10(reading.Settlement))
+		logQ = append(logQ, math.Log10(reading.Load))
+	}
+
+	best := math.Inf(1)
+	var slope1, intercept1, slope2, intercept2 float64
+	for i := 2; i <= len(readings)-2; i++ {
+		error1, s1, i1 := calcSquaredError(logQ[:i], logS[:i])
+		error2, s2, i2 := calcSquaredError(logQ[i:], logS[i:])
+		if error1+error2 < best && s1 != s2 {
+			best = error1 + error2
+			slope1, intercept1, slope2, intercept2 = s1, i1, s2, i2
+		}
+	}
+	if math.IsInf(best, 1) {
+		return result
+	}
+
+	logLoad := (intercept2 - intercept1) / (slope1 - slope2)
+	result.UltimateCapacity = math.Pow(10, logLoad)
+	result.Settlement = math.Pow(10, slope1*logLoad+intercept1)
+	result.IsFound = true
+	for _, q := range logQ {
+		s := slope1*q + intercept1
+		if q > logLoad {
+			s = slope2*q + intercept2
+		}
+		result.Loads = append(result.Loads, math.Pow(10, q))
+		result.Settlements = append(result.Settlements, math.Pow(10, s))
+	}
+	return result
+}
+
+// InterpretLoadTest interprets the ultimate capacity of a static axial pile load test by the methods of Davisson,
+// Chin-Kondner, Brinch Hansen 80% and De Beer.
+//
+// Parameters:
+//
+// - pile (models.Pile): The pile data. The length, diameter and elastic modulus are used by Davisson's method.
+//
+// - test (models.PileLoadTest): The load-settlement readings in increasing order of load.
+//
+// Returns:
+//
+// - result (LoadTestResult): Interpreted capacities and the fitted curves. Chin-Kondner's capacity is an asymptote,
+// so its settlement is left zero.
+func InterpretLoadTest(pile models.Pile, test models.PileLoadTest) LoadTestResult {
+	return LoadTestResult{
+		Davisson:     interpretDavisson(pile, test),
+		ChinKondner:  interpretChinKondner(test),
+		BrinchHansen: interpretBrinchHansen(test),
+		DeBeer:       interpretDeBeer(test),
+	}
+}
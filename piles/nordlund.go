@@ -0,0 +1,74 @@
+package piles
+
+import (
+	"math"
+
+	pkg "github.com/geoport/GeoGo/internal"
+	"github.com/geoport/GeoGo/models"
+)
+
+// Approximate values digitized from the design charts of Nordlund's method for uniform piles with a displaced volume
+// of about 0.1 m3/m (Hannigan et al., FHWA-NHI-16-009).
+var (
+	nordlundPhi      = []float64{25, 28, 30, 32, 34, 36, 38, 40}
+	nordlundKDelta   = []float64{0.85, 1.0, 1.15, 1.35, 1.6, 1.95, 2.4, 2.9}
+	nordlundNq       = []float64{12, 17, 22, 30, 42, 60, 90, 140}
+	nordlundAlphaT   = []float64{0.5, 0.52, 0.55, 0.57, 0.6, 0.63, 0.66, 0.7}
+	nordlundLimitTip = []float64{70, 130, 200, 290, 400, 550, 780, 1100} // t/m2
+
+	nordlundFrictionRatio = []float64{0.5, 0.6, 0.7, 0.8, 0.9, 1}
+	nordlundCF            = []float64{0.75, 0.8, 0.85, 0.9, 0.95, 1}
+)
+
+// calcNordlundCoefficient returns Kδ·CF·sin(δ) of Nordlund's (1963) method for a pile with no taper.
+func calcNordlundCoefficient(phi, frictionRatio float64) float64 {
+	Kdelta := pkg.Interp(phi, nordlundPhi, nordlundKDelta)
+	CF := pkg.Interp(frictionRatio, nordlundFrictionRatio, nordlundCF)
+	return Kdelta * CF * math.Sin(pkg.Radian(frictionRatio*phi))
+}
+
+// calcNordlundTipResistance returns the unit tip resistance of Nordlund's method limited by the limiting tip
+// resistance of Meyerhof (1976).
+func calcNordlundTipResistance(phi, effectiveStress float64) float64 {
+	alphaT := pkg.Interp(phi, nordlundPhi, nordlundAlphaT)
+	Nq := pkg.Interp(phi, nordlundPhi, nordlundNq)
+	return math.Min(alphaT*Nq*effectiveStress, pkg.Interp(phi, nordlundPhi, nordlundLimitTip))
+}
+
+// calcNordlund calculates the axial capacity with Nordlund's method in granular layers and the α-method in cohesive
+// layers.
+func calcNordlund(soilProfile models.SoilProfile, pile models.Pile) Capacity {
+	var capacity Capacity
+	perimeter := calcPerimeter(pile)
+	frictionRatio := getFrictionRatio(pile)
+
+	for _, layer := range getPileLayers(soilProfile, pile) {
+		if isCohesive(layer) {
+			capacity.Layers = append(capacity.Layers, calcAlphaLayer(soilProfile, pile, layer))
+			continue
+		}
+		effectiveStress := soilProfile.CalcEffectiveStress(layer.Center)
+		coefficient := calcNordlundCoefficient(layer.EffectiveFrictionAngle, frictionRatio)
+		unitSkinFriction := coefficient * effectiveStress
+		capacity.Layers = append(capacity.Layers, LayerResult{
+			Top:              layer.Depth - layer.Thickness,
+			Bottom:           layer.Depth,
+			Method:           "nordlund",
+			EffectiveStress:  effectiveStress,
+			Coefficient:      coefficient,
+			UnitSkinFriction: unitSkinFriction,
+			ShaftResistance:  unitSkinFriction * perimeter * layer.Thickness,
+		})
+	}
+
+	tipLayer := soilProfile.Layers[soilProfile.GetLayerIndex(pile.Length)]
+	if isCohesive(tipLayer) {
+		capacity.UnitTipResistance = calcCohesiveTipResistance(tipLayer)
+	} else {
+		capacity.UnitTipResistance = calcNordlundTipResistance(
+			tipLayer.EffectiveFrictionAngle, soilProfile.CalcEffectiveStress(pile.Length),
+		)
+	}
+
+	return sumCapacity(capacity, calcTipArea(pile))
+}
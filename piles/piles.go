@@ -0,0 +1,120 @@
+package piles
+
+import (
+	"math"
+
+	pkg "github.com/geoport/GeoGo/internal"
+	"github.com/geoport/GeoGo/models"
+)
+
+// safety factor applied to the ultimate axial capacity
+const safetyFactor = 2.5
+
+// end bearing factor of cohesive soils for deep foundations
+const nc = 9.
+
+// adhesion factor of bored piles (O'Neill & Reese, 1999)
+const boredAlpha = 0.55
+
+// calcAlpha returns the adhesion factor of API RP 2A for driven piles or O'Neill & Reese for bored piles.
+func calcAlpha(pile models.Pile, undrainedShearStrength, effectiveStress float64) float64 {
+	if pile.Type == "bored" {
+		return boredAlpha
+	}
+	psi := undrainedShearStrength / effectiveStress
+	var alpha float64
+	if psi <= 1 {
+		alpha = 0.5 * math.Pow(psi, -0.5)
+	} else {
+		alpha = 0.5 * math.Pow(psi, -0.25)
+	}
+	return math.Min(alpha, 1)
+}
+
+// calcBeta returns the shaft friction coefficient β = K·tan(δ) where K is derived from the at-rest coefficient.
+func calcBeta(pile models.Pile, phi float64) float64 {
+	K0 := 1 - math.Sin(pkg.Radian(phi))
+	K := getEarthPressureRatio(pile) * K0
+	return K * math.Tan(pkg.Radian(getFrictionRatio(pile)*phi))
+}
+
+// calcCohesiveTipResistance returns the unit tip resistance of the pile in a cohesive layer.
+func calcCohesiveTipResistance(layer models.SoilLayer) float64 {
+	return nc * layer.UndrainedShearStrength
+}
+
+// calcAlphaLayer calculates the shaft resistance of a cohesive layer with the α-method.
+func calcAlphaLayer(soilProfile models.SoilProfile, pile models.Pile, layer models.SoilLayer) LayerResult {
+	effectiveStress := soilProfile.CalcEffectiveStress(layer.Center)
+	alpha := calcAlpha(pile, layer.UndrainedShearStrength, effectiveStress)
+	unitSkinFriction := alpha * layer.UndrainedShearStrength
+	return LayerResult{
+		Top:              layer.Depth - layer.Thickness,
+		Bottom:           layer.Depth,
+		Method:           "alpha",
+		EffectiveStress:  effectiveStress,
+		Coefficient:      alpha,
+		UnitSkinFriction: unitSkinFriction,
+		ShaftResistance:  unitSkinFriction * calcPerimeter(pile) * layer.Thickness,
+	}
+}
+
+// calcBetaLayer calculates the shaft resistance of a granular layer with the β-method.
+func calcBetaLayer(soilProfile models.SoilProfile, pile models.Pile, layer models.SoilLayer) LayerResult {
+	effectiveStress := soilProfile.CalcEffectiveStress(layer.Center)
+	beta := calcBeta(pile, layer.EffectiveFrictionAngle)
+	unitSkinFriction := beta * effectiveStress
+	return LayerResult{
+		Top:              layer.Depth - layer.Thickness,
+		Bottom:           layer.Depth,
+		Method:           "beta",
+		EffectiveStress:  effectiveStress,
+		Coefficient:      beta,
+		UnitSkinFriction: unitSkinFriction,
+		ShaftResistance:  unitSkinFriction * calcPerimeter(pile) * layer.Thickness,
+	}
+}
+
+// calcAlphaBeta calculates the axial capacity with the α-method in cohesive layers and the β-method in granular
+// layers. The tip resistance is 9·Su in cohesive layers and Nq·σ'v in granular layers.
+func calcAlphaBeta(soilProfile models.SoilProfile, pile models.Pile) Capacity {
+	var capacity Capacity
+
+	for _, layer := range getPileLayers(soilProfile, pile) {
+		if isCohesive(layer) {
+			capacity.Layers = append(capacity.Layers, calcAlphaLayer(soilProfile, pile, layer))
+		} else {
+			capacity.Layers = append(capacity.Layers, calcBetaLayer(soilProfile, pile, layer))
+		}
+	}
+
+	tipLayer := soilProfile.Layers[soilProfile.GetLayerIndex(pile.Length)]
+	if isCohesive(tipLayer) {
+		capacity.UnitTipResistance = calcCohesiveTipResistance(tipLayer)
+	} else {
+		capacity.UnitTipResistance = calcJanbuNq(tipLayer.EffectiveFrictionAngle) *
+			soilProfile.CalcEffectiveStress(pile.Length)
+	}
+
+	return sumCapacity(capacity, calcTipArea(pile))
+}
+
+// CalcAxialCapacity calculates the axial compressive capacity of a single pile with the α and β methods and with
+// Nordlund's method.
+//
+// Parameters:
+//
+// - soilProfile (models.SoilProfile): The soil profile to be analyzed. Layers that are cohesive and have undrained
+// shear strength are analyzed with the α-method, the others with their effective friction angle.
+//
+// - pile (models.Pile): The pile data. The pile head is assumed to be at the ground surface.
+//
+// Returns:
+//
+// - result (Result)
+func CalcAxialCapacity(soilProfile models.SoilProfile, pile models.Pile) Result {
+	return Result{
+		AlphaBeta: calcAlphaBeta(soilProfile, pile),
+		Nordlund:  calcNordlund(soilProfile, pile),
+	}
+}
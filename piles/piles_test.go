@@ -0,0 +1,273 @@
+package piles
+
+import (
+	"testing"
+
+	dt "github.com/geoport/GeoGo/data"
+	pkg "github.com/geoport/GeoGo/internal"
+)
+
+func TestCalcJanbuNq(t *testing.T) {
+	output := calcJanbuNq(28)
+	expected := 14.72
+
+	if !pkg.AssertFloat(output, expected, 0.01) {
+		t.Errorf("Got %v, want %v", output, expected)
+	}
+}
+
+func TestCalcAxialCapacity(t *testing.T) {
+	soilProfile := dt.SoilProfile.Copy()
+	soilProfile.CalcLayerDepths()
+	pile := dt.PileData
+
+	output := CalcAxialCapacity(soilProfile, pile)
+
+	expectedMethods := []string{"alpha", "beta", "alpha"}
+	expectedShaft := []float64{12.44, 34.42, 48.38}
+	for i, layer := range output.AlphaBeta.Layers {
+		if layer.Method != expectedMethods[i] {
+			t.Errorf("Got %v, want %v for method of layer %v", layer.Method, expectedMethods[i], i)
+		}
+		if !pkg.AssertFloat(layer.ShaftResistance, expectedShaft[i], 0.01) {
+			t.Errorf("Got %v, want %v for shaft resistance of layer %v", layer.ShaftResistance, expectedShaft[i], i)
+		}
+	}
+	if !pkg.AssertFloat(output.AlphaBeta.TipResistance, 22.62, 0.01) {
+		t.Errorf("Got %v, want %v for tip resistance", output.AlphaBeta.TipResistance, 22.62)
+	}
+	if !pkg.AssertFloat(output.AlphaBeta.AllowableLoad, 47.14, 0.01) {
+		t.Errorf("Got %v, want %v for allowable load", output.AlphaBeta.AllowableLoad, 47.14)
+	}
+	if !pkg.AssertFloat(output.Nordlund.Layers[1].ShaftResistance, 57.28, 0.01) {
+		t.Errorf("Got %v, want %v for Nordlund shaft resistance", output.Nordlund.Layers[1].ShaftResistance, 57.28)
+	}
+
+	pile.Length = 6
+	output = CalcAxialCapacity(soilProfile, pile)
+	if !pkg.AssertFloat(output.AlphaBeta.UnitTipResistance, 150.4, 0.1) {
+		t.Errorf("Got %v, want %v for unit tip resistance", output.AlphaBeta.UnitTipResistance, 150.4)
+	}
+}
+
+func TestCalcSPTCapacity(t *testing.T) {
+	soilProfile := dt.SoilProfile.Copy()
+	soilProfile.CalcLayerDepths()
+	pile := dt.PileData
+	pile.Length = 10
+	pile.Diameter = 0.4
+
+	output := CalcSPTCapacity(soilProfile, pile, dt.SPT)
+
+	meyerhof := []float64{output.Meyerhof.AverageShaftValue, output.Meyerhof.AverageTipValue, output.Meyerhof.UnitTipResistance}
+	expected := []float64{14.5, 20.25, 270}
+	if !pkg.AssertFloatArray(meyerhof, expected, 0.01) {
+		t.Errorf("Got %v, want %v for Meyerhof", meyerhof, expected)
+	}
+
+	decourt := []float64{output.DecourtQuaresma.AverageShaftValue, output.DecourtQuaresma.AverageTipValue, output.DecourtQuaresma.UnitTipResistance}
+	expected = []float64{13, 23, 345}
+	if !pkg.AssertFloatArray(decourt, expected, 0.01) {
+		t.Errorf("Got %v, want %v for Decourt-Quaresma", decourt, expected)
+	}
+	if !pkg.AssertFloat(output.DecourtQuaresma.ShaftResistance, 43.56, 0.01) {
+		t.Errorf("Got %v, want %v for Decourt-Quaresma shaft resistance", output.DecourtQuaresma.ShaftResistance, 43.56)
+	}
+}
+
+func TestCalcCPTCapacity(t *testing.T) {
+	soilProfile := dt.SoilProfile.Copy()
+	soilProfile.CalcLayerDepths()
+	pile := dt.PileData
+	pile.Length = 10
+	pile.Diameter = 0.4
+
+	output := CalcCPTCapacity(soilProfile, pile, dt.CPT)
+
+	lcpc := []float64{output.LCPC.AverageTipValue, output.LCPC.UnitTipResistance, output.LCPC.ShaftResistance}
+	expected := []float64{861.67, 323.13, 43.98}
+	if !pkg.AssertFloatArray(lcpc, expected, 0.01) {
+		t.Errorf("Got %v, want %v for LCPC", lcpc, expected)
+	}
+
+	if output.EslamiFellenius.TipZoneTop != 6.8 {
+		t.Errorf("Got %v, want %v for Eslami-Fellenius tip zone", output.EslamiFellenius.TipZoneTop, 6.8)
+	}
+	eslami := []float64{output.EslamiFellenius.AverageTipValue, output.EslamiFellenius.ShaftResistance}
+	expected = []float64{806.25, 149.82}
+	if !pkg.AssertFloatArray(eslami, expected, 0.01) {
+		t.Errorf("Got %v, want %v for Eslami-Fellenius", eslami, expected)
+	}
+
+	categories := []string{"clay", "silt", "sandy silt", "sand"}
+	expected = []float64{0.025, 0.025, 0.01, 0.004}
+	for i, category := range categories {
+		if Cs := getEslamiCs(category, 3*pkg.MPa); Cs != expected[i] {
+			t.Errorf("Got %v, want %v for Cs of %v", Cs, expected[i], category)
+		}
+	}
+}
+
+func TestCalcFeld(t *testing.T) {
+	output := calcFeld(dt.PileGroupData)
+	expected := 0.6979
+
+	if !pkg.AssertFloat(output, expected, 0.0001) {
+		t.Errorf("Got %v, want %v", output, expected)
+	}
+}
+
+func TestCalcGroupCapacity(t *testing.T) {
+	soilProfile := dt.SoilProfile.Copy()
+	soilProfile.CalcLayerDepths()
+
+	output := CalcGroupCapacity(soilProfile, dt.PileData, dt.PileGroupData, 500)
+
+	if !pkg.AssertFloat(output.ConverseLabarre.Efficiency, 0.7098, 0.0001) {
+		t.Errorf("Got %v, want %v for Converse-Labarre efficiency", output.ConverseLabarre.Efficiency, 0.7098)
+	}
+	if !pkg.AssertFloat(output.BlockCapacity, 3484.49, 0.01) || output.BlockNc != 8.55 {
+		t.Errorf("Got %v (%v), want %v (%v) for block capacity", output.BlockCapacity, output.BlockNc, 3484.49, 8.55)
+	}
+	if !pkg.AssertFloat(output.AllowableLoad, 394.83, 0.01) || output.IsSafe {
+		t.Errorf("Got %v (%v), want %v (%v) for allowable load", output.AllowableLoad, output.IsSafe, 394.83, false)
+	}
+	if output.Settlement.RaftDepth != 10 || len(output.Settlement.Layers) != 8 {
+		t.Errorf("Got %v (%v layers), want %v (%v layers) for equivalent raft", output.Settlement.RaftDepth, len(output.Settlement.Layers), 10, 8)
+	}
+	if !pkg.AssertFloat(output.Settlement.Settlement, 6.05, 0.01) {
+		t.Errorf("Got %v, want %v for group settlement", output.Settlement.Settlement, 6.05)
+	}
+
+	for i := range soilProfile.Layers {
+		soilProfile.Layers[i].IsCohesive = false
+	}
+	output = CalcGroupCapacity(soilProfile, dt.PileData, dt.PileGroupData, 500)
+	if !pkg.AssertFloat(output.BlockCapacity, 9883.52, 0.01) {
+		t.Errorf("Got %v, want %v for block capacity in sand", output.BlockCapacity, 9883.52)
+	}
+	if !pkg.AssertFloat(output.UltimateCapacity, output.Feld.Capacity, 1e-6) || output.UltimateCapacity <= 0 {
+		t.Errorf("Got %v, want %v for ultimate capacity in sand", output.UltimateCapacity, output.Feld.Capacity)
+	}
+}
+
+func TestCalcSandCoefficients(t *testing.T) {
+	C1, C2, C3 := calcSandCoefficients(30)
+	output := []float64{C1, C2, C3}
+	expected := []float64{1.91, 2.67, 28.75}
+
+	if !pkg.AssertFloatArray(output, expected, 0.01) {
+		t.Errorf("Got %v, want %v", output, expected)
+	}
+}
+
+func TestCalcLateralResponse(t *testing.T) {
+	soilProfile := dt.SoilProfile.Copy()
+	soilProfile.CalcLayerDepths()
+
+	output := CalcLateralResponse(soilProfile, dt.PileData, 10, 0, "free")
+
+	if !output.IsConverged {
+		t.Errorf("Got %v, want %v for convergence", output.IsConverged, true)
+	}
+	if !pkg.AssertFloat(output.Shears[0], 10, 1e-6) || !pkg.AssertFloat(output.Moments[0], 0, 1e-6) {
+		t.Errorf("Got %v and %v, want %v and %v for head shear and moment", output.Shears[0], output.Moments[0], 10, 0)
+	}
+	if !pkg.AssertFloat(output.HeadDeflection, 0.0047, 0.0001) {
+		t.Errorf("Got %v, want %v for head deflection", output.HeadDeflection, 0.0047)
+	}
+	if !pkg.AssertFloat(output.MaxMoment, 17.94, 0.01) || output.MaxMomentDepth != 3.3 {
+		t.Errorf("Got %v at %v, want %v at %v for max moment", output.MaxMoment, output.MaxMomentDepth, 17.94, 3.3)
+	}
+	expectedTypes := []string{"matlock", "API sand", "reese"}
+	for i, curve := range output.Curves {
+		if curve.Type != expectedTypes[i] {
+			t.Errorf("Got %v, want %v for p-y curve of layer %v", curve.Type, expectedTypes[i], i)
+		}
+	}
+
+	output = CalcLateralResponse(soilProfile, dt.PileData, 10, 0, "fixed")
+	if output.HeadRotation != 0 || !pkg.AssertFloat(output.Moments[0], -18.39, 0.01) {
+		t.Errorf("Got %v and %v, want %v and %v for fixed head", output.HeadRotation, output.Moments[0], 0, -18.39)
+	}
+}
+
+func TestCalcBromsCapacity(t *testing.T) {
+	soilProfile := dt.SoilProfile.Copy()
+	soilProfile.CalcLayerDepths()
+	pile := dt.PileData
+
+	output := CalcBromsCapacity(soilProfile, pile, 0.5)
+	if output.SoilType != "cohesive" || output.Free.Mode != "long" || output.Fixed.Mode != "long" {
+		t.Errorf("Got %v (%v, %v), want %v (%v, %v)", output.SoilType, output.Free.Mode, output.Fixed.Mode, "cohesive", "long", "long")
+	}
+	loads := []float64{output.Free.UltimateLoad, output.Fixed.UltimateLoad}
+	expected := []float64{28.61, 59.30}
+	if !pkg.AssertFloatArray(loads, expected, 0.01) {
+		t.Errorf("Got %v, want %v for cohesive soil", loads, expected)
+	}
+
+	pile.Length = 4
+	pile.YieldMoment = 10000
+	output = CalcBromsCapacity(soilProfile, pile, 0)
+	if output.SoilType != "cohesionless" || output.Free.Mode != "short" || output.Fixed.Mode != "short" {
+		t.Errorf("Got %v (%v, %v), want %v (%v, %v)", output.SoilType, output.Free.Mode, output.Fixed.Mode, "cohesionless", "short", "short")
+	}
+	loads = []float64{output.Free.UltimateLoad, output.Fixed.UltimateLoad}
+	expected = []float64{32.35, 97.05}
+	if !pkg.AssertFloatArray(loads, expected, 0.01) {
+		t.Errorf("Got %v, want %v for cohesionless soil", loads, expected)
+	}
+
+	fixed := calcBromsCohesiveFixed(5, 0.5, 6, 60)
+	if fixed.Mode != "intermediate" || !pkg.AssertFloat(fixed.UltimateLoad, 54.09, 0.01) {
+		t.Errorf("Got %v (%v), want %v (%v) for intermediate mode", fixed.UltimateLoad, fixed.Mode, 54.09, "intermediate")
+	}
+
+	if _, ok := bisect(func(x float64) float64 { return x + 1 }, 0, 1); ok {
+		t.Errorf("Got %v, want %v for a root outside the range", ok, false)
+	}
+}
+
+func TestCalcDowndrag(t *testing.T) {
+	soilProfile := dt.SoilProfile.Copy()
+	soilProfile.CalcLayerDepths()
+
+	output := CalcDowndrag(soilProfile, dt.PileData, 40, 1)
+
+	if !pkg.AssertFloat(output.SoilSettlements[0], 8.18, 0.01) {
+		t.Errorf("Got %v, want %v for surface settlement", output.SoilSettlements[0], 8.18)
+	}
+	if !pkg.AssertFloat(output.NeutralPlaneDepth, 6.3, 1e-6) {
+		t.Errorf("Got %v, want %v for neutral plane", output.NeutralPlaneDepth, 6.3)
+	}
+	values := []float64{output.Dragload, output.MaxAxialForce, output.ToeResistance, output.DowndragSettlement, output.ToeMovement}
+	expected := []float64{31.90, 71.90, 8.22, 7.55, 1.06}
+	if !pkg.AssertFloatArray(values, expected, 0.01) {
+		t.Errorf("Got %v, want %v for dragload, max force, toe resistance and downdrag", values, expected)
+	}
+
+	output = CalcDowndrag(soilProfile, dt.PileData, 150, 1)
+	if output.NeutralPlaneDepth != 0 || output.Dragload != 0 {
+		t.Errorf("Got %v and %v, want %v and %v for overloaded pile", output.NeutralPlaneDepth, output.Dragload, 0, 0)
+	}
+}
+
+func TestInterpretLoadTest(t *testing.T) {
+	output := InterpretLoadTest(dt.PileData, dt.PileLoadTestData)
+
+	capacities := []float64{
+		output.Davisson.UltimateCapacity, output.ChinKondner.UltimateCapacity,
+		output.BrinchHansen.UltimateCapacity, output.DeBeer.UltimateCapacity,
+	}
+	expected := []float64{160.08, 199.81, 215.96, 129.94}
+	if !pkg.AssertFloatArray(capacities, expected, 0.01) {
+		t.Errorf("Got %v, want %v", capacities, expected)
+	}
+	if !pkg.AssertFloat(output.Davisson.Settlement, 1.206, 0.001) {
+		t.Errorf("Got %v, want %v for Davisson settlement", output.Davisson.Settlement, 1.206)
+	}
+	if !pkg.AssertFloat(output.BrinchHansen.Settlement, 7.04, 0.01) {
+		t.Errorf("Got %v, want %v for Brinch Hansen settlement", output.BrinchHansen.Settlement, 7.04)
+	}
+}
@@ -0,0 +1,134 @@
+package piles
+
+import (
+	"math"
+
+	pkg "github.com/geoport/GeoGo/internal"
+	"github.com/geoport/GeoGo/models"
+)
+
+// undrained shear strength (in t/m2) above which clays are analyzed as stiff clay
+const stiffClayStrength = 5.
+
+// empirical coefficient of Matlock's ultimate resistance
+const J = 0.5
+
+// Approximate initial modulus of subgrade reaction of sands (in t/m3) digitized from API RP 2A Figure 6.8.7-1.
+var (
+	sandModulusPhi   = []float64{25, 30, 35, 40}
+	sandModulusAbove = []float64{510, 2040, 4590, 8160}
+	sandModulusBelow = []float64{510, 1430, 2960, 4590}
+)
+
+// pyCurve is the p-y relation of the soil at a depth.
+type pyCurve struct {
+	Type string
+	P    func(y float64) float64
+}
+
+// calcEpsilon50 returns the strain at half the maximum deviator stress of clays for the undrained shear strength
+// in t/m2 (Reese & Van Impe, 2001).
+func calcEpsilon50(Su float64) float64 {
+	switch {
+	case Su < 2.5:
+		return 0.02
+	case Su < 5:
+		return 0.01
+	case Su < 10:
+		return 0.007
+	case Su < 20:
+		return 0.005
+	default:
+		return 0.004
+	}
+}
+
+// calcClayUltimateResistance returns the ultimate soil resistance of clays (in t/m) by Matlock (1970).
+func calcClayUltimateResistance(Su, effectiveStress, z, b float64) float64 {
+	return math.Min(3+effectiveStress/Su+J*z/b, 9) * Su * b
+}
+
+// newMatlockCurve returns the static p-y curve of soft clay by Matlock (1970).
+func newMatlockCurve(Su, effectiveStress, z, b float64) pyCurve {
+	pu := calcClayUltimateResistance(Su, effectiveStress, z, b)
+	y50 := 2.5 * calcEpsilon50(Su) * b
+	return pyCurve{
+		Type: "matlock",
+		P: func(y float64) float64 {
+			if y >= 8*y50 {
+				return pu
+			}
+			return 0.5 * pu * math.Cbrt(y/y50)
+		},
+	}
+}
+
+// newReeseStiffClayCurve returns the static p-y curve of stiff clay with no free water by Welch & Reese (1972).
+func newReeseStiffClayCurve(Su, effectiveStress, z, b float64) pyCurve {
+	pu := calcClayUltimateResistance(Su, effectiveStress, z, b)
+	y50 := 2.5 * calcEpsilon50(Su) * b
+	return pyCurve{
+		Type: "reese",
+		P: func(y float64) float64 {
+			if y >= 16*y50 {
+				return pu
+			}
+			return 0.5 * pu * math.Pow(y/y50, 0.25)
+		},
+	}
+}
+
+// calcSandCoefficients returns the coefficients C1, C2 and C3 of the ultimate resistance of sands (API RP 2A).
+func calcSandCoefficients(phi float64) (float64, float64, float64) {
+	K0 := 0.4
+	alpha := pkg.Radian(phi / 2)
+	beta := pkg.Radian(45 + phi/2)
+	phiR := pkg.Radian(phi)
+	Ka := math.Pow(math.Tan(pkg.Radian(45-phi/2)), 2)
+	tanB := math.Tan(beta)
+	tanBP := math.Tan(beta - phiR)
+
+	C1 := tanB*tanB*math.Tan(alpha)/tanBP +
+		K0*(math.Tan(phiR)*math.Sin(beta)/(math.Cos(alpha)*tanBP)+tanB*(math.Tan(phiR)*math.Sin(beta)-math.Tan(alpha)))
+	C2 := tanB/tanBP - Ka
+	C3 := Ka*(math.Pow(tanB, 8)-1) + K0*math.Tan(phiR)*math.Pow(tanB, 4)
+	return C1, C2, C3
+}
+
+// newAPISandCurve returns the static p-y curve of sand by API RP 2A.
+func newAPISandCurve(phi, effectiveStress, z, b float64, isSubmerged bool) pyCurve {
+	C1, C2, C3 := calcSandCoefficients(phi)
+	pu := math.Min((C1*z+C2*b)*effectiveStress, C3*b*effectiveStress)
+	A := math.Max(3-0.8*z/b, 0.9)
+
+	k := pkg.Interp(phi, sandModulusPhi, sandModulusAbove)
+	if isSubmerged {
+		k = pkg.Interp(phi, sandModulusPhi, sandModulusBelow)
+	}
+
+	return pyCurve{
+		Type: "API sand",
+		P: func(y float64) float64 {
+			if pu <= 0 {
+				return 0
+			}
+			return A * pu * math.Tanh(k*z*y/(A*pu))
+		},
+	}
+}
+
+// getPYCurve returns the p-y curve of the soil at the given depth. Cohesive layers with undrained shear strength
+// use Matlock's soft clay or Reese's stiff clay curve depending on the strength, the others API sand curve.
+func getPYCurve(soilProfile models.SoilProfile, pile models.Pile, z float64) pyCurve {
+	layer := soilProfile.Layers[soilProfile.GetLayerIndex(z)]
+	effectiveStress := soilProfile.CalcEffectiveStress(z)
+	b := pile.Diameter
+
+	if isCohesive(layer) {
+		if layer.UndrainedShearStrength < stiffClayStrength {
+			return newMatlockCurve(layer.UndrainedShearStrength, effectiveStress, z, b)
+		}
+		return newReeseStiffClayCurve(layer.UndrainedShearStrength, effectiveStress, z, b)
+	}
+	return newAPISandCurve(layer.EffectiveFrictionAngle, effectiveStress, z, b, z > soilProfile.Gwt)
+}
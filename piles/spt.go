@@ -0,0 +1,156 @@
+package piles
+
+import (
+	"math"
+
+	"github.com/geoport/GeoGo/models"
+)
+
+// getNearestExpIndex returns the index of the experiment closest to the given depth.
+func getNearestExpIndex(depths []float64, depth float64) int {
+	index := 0
+	for i, d := range depths {
+		if math.Abs(d-depth) < math.Abs(depths[index]-depth) {
+			index = i
+		}
+	}
+	return index
+}
+
+// calcMeanN returns the average blow count of the experiments between the given depths. If there is no experiment
+// in the range, the blow count of the experiment closest to the center of the range is returned.
+func calcMeanN(sptData models.SPT, top, bottom float64) float64 {
+	var sum, count float64
+	var depths []float64
+	for _, exp := range sptData.Exps {
+		depths = append(depths, exp.Depth)
+		if exp.Depth >= top && exp.Depth <= bottom {
+			sum += exp.GetN60()
+			count++
+		}
+	}
+	if count == 0 {
+		return sptData.Exps[getNearestExpIndex(depths, (top+bottom)/2)].GetN60()
+	}
+	return sum / count
+}
+
+// calcMeyerhofSPT calculates the capacity by Meyerhof (1976). The tip blow count is averaged between 10D above and
+// 4D below the tip. Bored piles mobilize one third of the tip resistance and half of the skin friction of driven
+// displacement piles.
+func calcMeyerhofSPT(pile models.Pile, sptData models.SPT) FieldTestCapacity {
+	L := pile.Length
+	D := pile.Diameter
+	top := L - 10*D
+	bottom := L + 4*D
+
+	Ns := calcMeanN(sptData, 0, L)
+	Nb := calcMeanN(sptData, top, bottom)
+
+	// skin friction coefficient in t/m2 per blow of displacement piles
+	fsFactor := 0.2
+	if pile.Type == "bored" || pile.Material == "steel" {
+		fsFactor = 0.1
+	}
+	fs := math.Min(fsFactor*Ns, 10)
+
+	qp := math.Min(4*Nb*L/D, 40*Nb)
+	if pile.Type == "bored" {
+		qp /= 3
+	}
+
+	capacity := newFieldTestCapacity(pile, fs*calcPerimeter(pile)*L, qp)
+	capacity.TipZoneTop = top
+	capacity.TipZoneBottom = bottom
+	capacity.AverageShaftValue = Ns
+	capacity.AverageTipValue = Nb
+
+	return capacity
+}
+
+// getDecourtFactors returns the tip coefficient K (in t/m2) and the tip and shaft reduction factors of bored piles
+// for the soil category of Decourt & Quaresma (1978) and Decourt (1996).
+func getDecourtFactors(soilCategory string) (float64, float64, float64) {
+	switch soilCategory {
+	case "clay":
+		return 12, 0.85, 0.8
+	case "silt":
+		return 20, 0.6, 0.65
+	case "sandy silt":
+		return 25, 0.6, 0.65
+	default:
+		return 40, 0.5, 0.5
+	}
+}
+
+// calcDecourtQuaresma calculates the capacity by Decourt & Quaresma (1978). The tip blow count is the average of the
+// experiment closest to the tip and its neighbours; the remaining experiments above the tip are used for the shaft
+// with N limited between 3 and 50.
+func calcDecourtQuaresma(soilProfile models.SoilProfile, pile models.Pile, sptData models.SPT) FieldTestCapacity {
+	L := pile.Length
+	var depths []float64
+	for _, exp := range sptData.Exps {
+		depths = append(depths, exp.Depth)
+	}
+
+	tipIndex := getNearestExpIndex(depths, L)
+	first := int(math.Max(float64(tipIndex-1), 0))
+	last := int(math.Min(float64(tipIndex+1), float64(len(depths)-1)))
+
+	var Np float64
+	for i := first; i <= last; i++ {
+		Np += sptData.Exps[i].GetN60()
+	}
+	Np /= float64(last - first + 1)
+
+	var Ns, count float64
+	for i, exp := range sptData.Exps {
+		if i < first && exp.Depth <= L {
+			Ns += math.Min(math.Max(exp.GetN60(), 3), 50)
+			count++
+		}
+	}
+	if count > 0 {
+		Ns /= count
+	} else {
+		Ns = 3
+	}
+
+	tipLayer := soilProfile.Layers[soilProfile.GetLayerIndex(L)]
+	K, tipFactor, shaftFactor := getDecourtFactors(getSoilCategory(tipLayer))
+	if pile.Type != "bored" {
+		tipFactor, shaftFactor = 1, 1
+	}
+
+	fs := shaftFactor * (Ns/3 + 1)
+	qp := tipFactor * K * Np
+
+	capacity := newFieldTestCapacity(pile, fs*calcPerimeter(pile)*L, qp)
+	capacity.TipZoneTop = depths[first]
+	capacity.TipZoneBottom = depths[last]
+	capacity.AverageShaftValue = Ns
+	capacity.AverageTipValue = Np
+
+	return capacity
+}
+
+// CalcSPTCapacity calculates the axial compressive capacity of a single pile from SPT blow counts with the methods of
+// Meyerhof (1976) and Decourt & Quaresma (1978).
+//
+// Parameters:
+//
+// - soilProfile (models.SoilProfile): The soil profile. The soil class of the tip layer is used by Decourt & Quaresma.
+//
+// - pile (models.Pile): The pile data.
+//
+// - sptData (models.SPT): SPT experiments. N60 is used if it is given, otherwise N.
+//
+// Returns:
+//
+// - result (SPTResult)
+func CalcSPTCapacity(soilProfile models.SoilProfile, pile models.Pile, sptData models.SPT) SPTResult {
+	return SPTResult{
+		Meyerhof:        calcMeyerhofSPT(pile, sptData),
+		DecourtQuaresma: calcDecourtQuaresma(soilProfile, pile, sptData),
+	}
+}
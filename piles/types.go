@@ -0,0 +1,153 @@
+package piles
+
+type Result struct {
+	AlphaBeta Capacity `json:"alphaBeta"`
+	Nordlund  Capacity `json:"nordlund"`
+}
+
+type Capacity struct {
+	Layers            []LayerResult `json:"layers"`
+	ShaftResistance   float64       `json:"shaftResistance"`   // ton
+	TipResistance     float64       `json:"tipResistance"`     // ton
+	UnitTipResistance float64       `json:"unitTipResistance"` // t/m2
+	UltimateCapacity  float64       `json:"ultimateCapacity"`  // ton
+	SafetyFactor      float64       `json:"safetyFactor"`
+	AllowableLoad     float64       `json:"allowableLoad"` // ton
+}
+
+type LayerResult struct {
+	Top              float64 `json:"top"`              // meter
+	Bottom           float64 `json:"bottom"`           // meter
+	Method           string  `json:"method"`           // "alpha", "beta" or "nordlund"
+	EffectiveStress  float64 `json:"effectiveStress"`  // t/m2, at the center of the layer
+	Coefficient      float64 `json:"coefficient"`      // α, β or Kδ·CF·sin(δ)
+	UnitSkinFriction float64 `json:"unitSkinFriction"` // t/m2
+	ShaftResistance  float64 `json:"shaftResistance"`  // ton
+}
+
+type SPTResult struct {
+	Meyerhof        FieldTestCapacity `json:"meyerhof"`
+	DecourtQuaresma FieldTestCapacity `json:"decourtQuaresma"`
+}
+
+type CPTResult struct {
+	LCPC            FieldTestCapacity `json:"LCPC"`
+	EslamiFellenius FieldTestCapacity `json:"eslamiFellenius"`
+}
+
+type FieldTestCapacity struct {
+	TipZoneTop        float64 `json:"tipZoneTop"`        // meter
+	TipZoneBottom     float64 `json:"tipZoneBottom"`     // meter
+	AverageShaftValue float64 `json:"averageShaftValue"` // N or cone resistance (t/m2) along the shaft
+	AverageTipValue   float64 `json:"averageTipValue"`   // N or cone resistance (t/m2) in the tip zone
+	UnitSkinFriction  float64 `json:"unitSkinFriction"`  // t/m2, average along the shaft
+	UnitTipResistance float64 `json:"unitTipResistance"` // t/m2
+	ShaftResistance   float64 `json:"shaftResistance"`   // ton
+	TipResistance     float64 `json:"tipResistance"`     // ton
+	UltimateCapacity  float64 `json:"ultimateCapacity"`  // ton
+	SafetyFactor      float64 `json:"safetyFactor"`
+	AllowableLoad     float64 `json:"allowableLoad"` // ton
+}
+
+type GroupResult struct {
+	PileCount          int             `json:"pileCount"`
+	Width              float64         `json:"width"`              // meter, outer width of the group along the columns
+	Length             float64         `json:"length"`             // meter, outer length of the group along the rows
+	SinglePileCapacity float64         `json:"singlePileCapacity"` // ton
+	ConverseLabarre    GroupEfficiency `json:"converseLabarre"`
+	Feld               GroupEfficiency `json:"feld"`
+	BlockCapacity      float64         `json:"blockCapacity"` // ton
+	BlockNc            float64         `json:"blockNc"`
+	UltimateCapacity   float64         `json:"ultimateCapacity"` // ton
+	AllowableLoad      float64         `json:"allowableLoad"`    // ton
+	IsSafe             bool            `json:"isSafe"`
+	Settlement         GroupSettlement `json:"settlement"`
+}
+
+type GroupEfficiency struct {
+	Efficiency float64 `json:"efficiency"`
+	Capacity   float64 `json:"capacity"` // ton
+}
+
+type GroupSettlement struct {
+	RaftDepth    float64           `json:"raftDepth"`    // meter
+	RaftPressure float64           `json:"raftPressure"` // t/m2
+	Layers       []SettlementLayer `json:"layers"`
+	Settlement   float64           `json:"settlement"` // cm
+}
+
+type SettlementLayer struct {
+	Top             float64 `json:"top"`             // meter
+	Bottom          float64 `json:"bottom"`          // meter
+	EffectiveStress float64 `json:"effectiveStress"` // t/m2
+	StressIncrease  float64 `json:"stressIncrease"`  // t/m2
+	Method          string  `json:"method"`          // "mv", "Cc" or "elastic"
+	Settlement      float64 `json:"settlement"`      // cm
+}
+
+type LateralResult struct {
+	HeadCondition  string    `json:"headCondition"`
+	Depths         []float64 `json:"depths"`         // meter
+	Deflections    []float64 `json:"deflections"`    // meter
+	Rotations      []float64 `json:"rotations"`      // radian
+	Moments        []float64 `json:"moments"`        // t.m
+	Shears         []float64 `json:"shears"`         // ton
+	SoilReactions  []float64 `json:"soilReactions"`  // t/m
+	HeadDeflection float64   `json:"headDeflection"` // meter
+	HeadRotation   float64   `json:"headRotation"`   // radian
+	MaxMoment      float64   `json:"maxMoment"`      // t.m, absolute maximum
+	MaxMomentDepth float64   `json:"maxMomentDepth"` // meter
+	Iterations     int       `json:"iterations"`
+	IsConverged    bool      `json:"isConverged"`
+	Curves         []PYCurve `json:"curves"`
+}
+
+type PYCurve struct {
+	Depth float64   `json:"depth"` // meter
+	Type  string    `json:"type"`  // "matlock", "reese" or "API sand"
+	Y     []float64 `json:"y"`     // meter
+	P     []float64 `json:"p"`     // t/m
+}
+
+type BromsResult struct {
+	SoilType string        `json:"soilType"` // "cohesive" or "cohesionless"
+	Free     BromsCapacity `json:"free"`
+	Fixed    BromsCapacity `json:"fixed"`
+}
+
+type BromsCapacity struct {
+	Mode          string  `json:"mode"`         // "short", "intermediate" or "long"
+	UltimateLoad  float64 `json:"ultimateLoad"` // ton
+	SafetyFactor  float64 `json:"safetyFactor"`
+	AllowableLoad float64 `json:"allowableLoad"` // ton
+}
+
+type DowndragResult struct {
+	Depths             []float64 `json:"depths"`             // meter
+	SoilSettlements    []float64 `json:"soilSettlements"`    // cm
+	LoadCurve          []float64 `json:"loadCurve"`          // ton, dead load plus negative skin friction from the head
+	ResistanceCurve    []float64 `json:"resistanceCurve"`    // ton, toe resistance plus positive shaft resistance from the toe
+	AxialForces        []float64 `json:"axialForces"`        // ton
+	NeutralPlaneDepth  float64   `json:"neutralPlaneDepth"`  // meter
+	Dragload           float64   `json:"dragload"`           // ton
+	MaxAxialForce      float64   `json:"maxAxialForce"`      // ton
+	ToeResistance      float64   `json:"toeResistance"`      // ton, mobilized
+	ToeMovement        float64   `json:"toeMovement"`        // cm, toe penetration relative to the soil
+	DowndragSettlement float64   `json:"downdragSettlement"` // cm, settlement of the pile head
+	Iterations         int       `json:"iterations"`
+}
+
+type LoadTestResult struct {
+	Davisson     LoadTestInterpretation `json:"davisson"`
+	ChinKondner  LoadTestInterpretation `json:"chinKondner"`
+	BrinchHansen LoadTestInterpretation `json:"brinchHansen"`
+	DeBeer       LoadTestInterpretation `json:"deBeer"`
+}
+
+type LoadTestInterpretation struct {
+	UltimateCapacity float64   `json:"ultimateCapacity"` // ton
+	Settlement       float64   `json:"settlement"`       // cm, settlement at the ultimate capacity
+	IsFound          bool      `json:"isFound"`
+	Loads            []float64 `json:"loads"`       // ton, fitted curve
+	Settlements      []float64 `json:"settlements"` // cm, fitted curve
+}
@@ -0,0 +1,59 @@
+package rock_mass
+
+import (
+	"math"
+
+	"github.com/geoport/GeoGo/models"
+)
+
+// getQClass returns the rock mass class of the Q-system for the given Q value.
+func getQClass(Q float64) string {
+	limits := []float64{0.01, 0.1, 1, 4, 10, 40, 100, 400}
+	classes := []string{
+		"Exceptionally poor", "Extremely poor", "Very poor", "Poor", "Fair", "Good", "Very good", "Extremely good",
+	}
+	for i, limit := range limits {
+		if Q < limit {
+			return classes[i]
+		}
+	}
+	return "Exceptionally good"
+}
+
+// CalcQ calculates the rock mass quality Q (Barton et al., 1974) and the corresponding RMR (Bieniawski, 1976) and
+// GSI (Hoek et al., 1995).
+//
+// Parameters:
+//
+// - input (QInput): Q-system ratings.
+//
+// - layer (models.SoilLayer): The rock layer. Its RQD is used when it is not given in the input.
+//
+// Returns:
+//
+// - result (QResult): A zero result is returned if Jn, Ja or SRF is not positive.
+func CalcQ(input QInput, layer models.SoilLayer) QResult {
+	RQD := input.RQD
+	if RQD <= 0 {
+		RQD = layer.RQD
+	}
+	RQD = math.Max(RQD, 10)
+	if input.Jn <= 0 || input.Ja <= 0 || input.SRF <= 0 {
+		return QResult{}
+	}
+
+	blockSize := RQD / input.Jn
+	shearStress := input.Jr / input.Ja
+	activeStress := input.Jw / input.SRF
+	Q := blockSize * shearStress * activeStress
+
+	return QResult{
+		Q:            Q,
+		BlockSize:    blockSize,
+		ShearStress:  shearStress,
+		ActiveStress: activeStress,
+		Class:        getQClass(Q),
+		RMR:          9*math.Log(Q) + 44,
+		GSI:          9*math.Log(blockSize*shearStress) + 44,
+	}
+}
@@ -0,0 +1,207 @@
+package rock_mass
+
+import (
+	pkg "github.com/geoport/GeoGo/internal"
+	"github.com/geoport/GeoGo/models"
+)
+
+// getStrengthRating returns the RMR89 rating of intact rock strength. The point load index is used if the uniaxial
+// compressive strength is not given, low point load indexes are converted to UCS with the Kp of the layer.
+func getStrengthRating(UCS, IS50 float64, layer models.SoilLayer) float64 {
+	if UCS <= 0 && IS50 > 0 {
+		is50 := IS50 / pkg.MPa
+		switch {
+		case is50 > 10:
+			return 15
+		case is50 > 4:
+			return 12
+		case is50 > 2:
+			return 7
+		case is50 > 1:
+			return 4
+		}
+		// low strength rocks are rated with UCS, Is50 is not reliable
+		UCS = layer.EstimateUCS(IS50)
+	}
+
+	ucs := UCS / pkg.MPa
+	switch {
+	case ucs > 250:
+		return 15
+	case ucs > 100:
+		return 12
+	case ucs > 50:
+		return 7
+	case ucs > 25:
+		return 4
+	case ucs > 5:
+		return 2
+	case ucs >= 1:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// getRQDRating returns the RMR89 rating of RQD (in %).
+func getRQDRating(RQD float64) float64 {
+	switch {
+	case RQD >= 90:
+		return 20
+	case RQD >= 75:
+		return 17
+	case RQD >= 50:
+		return 13
+	case RQD >= 25:
+		return 8
+	default:
+		return 3
+	}
+}
+
+// getSpacingRating returns the RMR89 rating of discontinuity spacing (in meters).
+func getSpacingRating(spacing float64) float64 {
+	switch {
+	case spacing > 2:
+		return 20
+	case spacing > 0.6:
+		return 15
+	case spacing > 0.2:
+		return 10
+	case spacing > 0.06:
+		return 8
+	default:
+		return 5
+	}
+}
+
+// getConditionRating returns the RMR89 rating of discontinuity condition as the sum of the guideline ratings of
+// persistence, aperture, roughness, infilling and weathering.
+func getConditionRating(input RMRInput) float64 {
+	var persistence, aperture float64
+	switch {
+	case input.Persistence < 1:
+		persistence = 6
+	case input.Persistence < 3:
+		persistence = 4
+	case input.Persistence < 10:
+		persistence = 2
+	case input.Persistence < 20:
+		persistence = 1
+	}
+
+	switch {
+	case input.Aperture == 0:
+		aperture = 6
+	case input.Aperture < 0.1:
+		aperture = 5
+	case input.Aperture <= 1:
+		aperture = 4
+	case input.Aperture <= 5:
+		aperture = 1
+	}
+
+	roughness := map[string]float64{
+		"very rough": 6, "rough": 5, "slightly rough": 3, "smooth": 1, "slickensided": 0,
+	}[input.Roughness]
+	infilling := map[string]float64{
+		"none": 6, "hard < 5mm": 4, "hard > 5mm": 2, "soft < 5mm": 2, "soft > 5mm": 0,
+	}[input.Infilling]
+	weathering := map[string]float64{
+		"unweathered": 6, "slightly": 5, "moderately": 3, "highly": 1, "decomposed": 0,
+	}[input.Weathering]
+
+	return persistence + aperture + roughness + infilling + weathering
+}
+
+// getGroundwaterRating returns the RMR89 rating of groundwater conditions.
+func getGroundwaterRating(groundwater string) float64 {
+	return map[string]float64{
+		"completely dry": 15, "damp": 10, "wet": 7, "dripping": 4, "flowing": 0,
+	}[groundwater]
+}
+
+// getOrientationAdjustment returns the RMR89 rating adjustment for discontinuity orientations.
+func getOrientationAdjustment(orientation, application string) float64 {
+	orientations := []string{"very favourable", "favourable", "fair", "unfavourable", "very unfavourable"}
+	adjustments := map[string][]float64{
+		"tunnel":     {0, -2, -5, -10, -12},
+		"foundation": {0, -2, -7, -15, -25},
+		"slope":      {0, -5, -25, -50, -60},
+	}
+
+	values, ok := adjustments[application]
+	if !ok {
+		return 0
+	}
+	for i, o := range orientations {
+		if o == orientation {
+			return values[i]
+		}
+	}
+	return 0
+}
+
+// getRMRClass returns the class and description of the rock mass for the given RMR.
+func getRMRClass(RMR float64) (string, string) {
+	switch {
+	case RMR > 80:
+		return "I", "Very good rock"
+	case RMR > 60:
+		return "II", "Good rock"
+	case RMR > 40:
+		return "III", "Fair rock"
+	case RMR > 20:
+		return "IV", "Poor rock"
+	default:
+		return "V", "Very poor rock"
+	}
+}
+
+// CalcRMR calculates the rock mass rating (Bieniawski, 1989) and the corresponding GSI.
+//
+// Parameters:
+//
+// - input (RMRInput): Rock mass parameters.
+//
+// - layer (models.SoilLayer): The rock layer. Its RQD and IS50 are used when they are not given in the input.
+//
+// Returns:
+//
+// - result (RMRResult)
+func CalcRMR(input RMRInput, layer models.SoilLayer) RMRResult {
+	if input.RQD <= 0 {
+		input.RQD = layer.RQD
+	}
+	if input.UCS <= 0 && input.IS50 <= 0 {
+		input.IS50 = layer.IS50
+	}
+
+	strengthRating := getStrengthRating(input.UCS, input.IS50, layer)
+	RQDRating := getRQDRating(input.RQD)
+	spacingRating := getSpacingRating(input.Spacing)
+	conditionRating := getConditionRating(input)
+	groundwaterRating := getGroundwaterRating(input.Groundwater)
+	orientationAdjustment := getOrientationAdjustment(input.Orientation, input.Application)
+
+	basicRMR := strengthRating + RQDRating + spacingRating + conditionRating + groundwaterRating
+	RMR := basicRMR + orientationAdjustment
+	class, description := getRMRClass(RMR)
+
+	// GSI = RMR89 - 5 with dry groundwater conditions and no orientation adjustment (Hoek & Brown, 1997)
+	GSI := basicRMR - groundwaterRating + 15 - 5
+
+	return RMRResult{
+		StrengthRating:        strengthRating,
+		RQDRating:             RQDRating,
+		SpacingRating:         spacingRating,
+		ConditionRating:       conditionRating,
+		GroundwaterRating:     groundwaterRating,
+		OrientationAdjustment: orientationAdjustment,
+		BasicRMR:              basicRMR,
+		RMR:                   RMR,
+		Class:                 class,
+		Description:           description,
+		GSI:                   GSI,
+	}
+}
@@ -0,0 +1,83 @@
+package rock_mass
+
+import (
+	"testing"
+
+	dt "github.com/geoport/GeoGo/data"
+	pkg "github.com/geoport/GeoGo/internal"
+)
+
+func TestCalcRMR(t *testing.T) {
+	input := RMRInput{
+		UCS:         12236,
+		RQD:         80,
+		Spacing:     0.5,
+		Persistence: 2,
+		Aperture:    0.5,
+		Roughness:   "rough",
+		Infilling:   "none",
+		Weathering:  "slightly",
+		Groundwater: "damp",
+		Orientation: "fair",
+		Application: "foundation",
+	}
+
+	output := CalcRMR(input, dt.SoilProfile.Layers[0])
+
+	if output.ConditionRating != 24 {
+		t.Errorf("Got %v, want %v for condition rating", output.ConditionRating, 24)
+	}
+	if output.BasicRMR != 73 {
+		t.Errorf("Got %v, want %v for basic RMR", output.BasicRMR, 73)
+	}
+	if output.RMR != 66 || output.Class != "II" {
+		t.Errorf("Got %v (%v), want %v (%v) for RMR", output.RMR, output.Class, 66, "II")
+	}
+	if output.GSI != 73 {
+		t.Errorf("Got %v, want %v for GSI", output.GSI, 73)
+	}
+}
+
+func TestCalcRMRWithLayer(t *testing.T) {
+	// RQD = 30 and IS50 = 122.37 t/m2 of the layer are used
+	output := CalcRMR(RMRInput{}, dt.SoilProfile.Layers[0])
+
+	if output.RQDRating != 8 {
+		t.Errorf("Got %v, want %v for RQD rating", output.RQDRating, 8)
+	}
+	if output.StrengthRating != 4 {
+		t.Errorf("Got %v, want %v for strength rating", output.StrengthRating, 4)
+	}
+
+	// Is50 = 0.9 MPa is converted with Kp of the layer: 12.6 MPa with Kp = 14, 27 MPa with Kp = 30
+	layer := dt.SoilProfile.Layers[0]
+	layer.IS50 = 0.9 * pkg.MPa
+	if rating := CalcRMR(RMRInput{}, layer).StrengthRating; rating != 2 {
+		t.Errorf("Got %v, want %v for strength rating with Kp = 14", rating, 2)
+	}
+	layer.Kp = 30
+	if rating := CalcRMR(RMRInput{}, layer).StrengthRating; rating != 4 {
+		t.Errorf("Got %v, want %v for strength rating with Kp = 30", rating, 4)
+	}
+}
+
+func TestCalcQ(t *testing.T) {
+	input := QInput{RQD: 80, Jn: 9, Jr: 1.5, Ja: 1, Jw: 1, SRF: 1}
+	output := CalcQ(input, dt.SoilProfile.Layers[0])
+
+	if !pkg.AssertFloat(output.Q, 13.33, 0.01) {
+		t.Errorf("Got %v, want %v for Q", output.Q, 13.33)
+	}
+	if output.Class != "Good" {
+		t.Errorf("Got %v, want %v for class", output.Class, "Good")
+	}
+	if !pkg.AssertFloat(output.RMR, 67.31, 0.01) {
+		t.Errorf("Got %v, want %v for RMR", output.RMR, 67.31)
+	}
+
+	input.Jn = 0
+	output = CalcQ(input, dt.SoilProfile.Layers[0])
+	if output != (QResult{}) {
+		t.Errorf("Got %v, want zero result for Jn = 0", output)
+	}
+}
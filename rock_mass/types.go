@@ -0,0 +1,49 @@
+package rock_mass
+
+type RMRInput struct {
+	UCS         float64 `json:"UCS"`         // t/m2, uniaxial compressive strength of intact rock
+	IS50        float64 `json:"IS50"`        // t/m2, point load strength index, used when UCS is not given
+	RQD         float64 `json:"RQD"`         // %
+	Spacing     float64 `json:"spacing"`     // meter, spacing of discontinuities
+	Persistence float64 `json:"persistence"` // meter, discontinuity length
+	Aperture    float64 `json:"aperture"`    // mm, separation of discontinuities
+	Roughness   string  `json:"roughness"`   // "very rough", "rough", "slightly rough", "smooth" or "slickensided"
+	Infilling   string  `json:"infilling"`   // "none", "hard < 5mm", "hard > 5mm", "soft < 5mm" or "soft > 5mm"
+	Weathering  string  `json:"weathering"`  // "unweathered", "slightly", "moderately", "highly" or "decomposed"
+	Groundwater string  `json:"groundwater"` // "completely dry", "damp", "wet", "dripping" or "flowing"
+	Orientation string  `json:"orientation"` // "very favourable", "favourable", "fair", "unfavourable" or "very unfavourable"
+	Application string  `json:"application"` // "tunnel", "foundation" or "slope"
+}
+
+type RMRResult struct {
+	StrengthRating        float64 `json:"strengthRating"`
+	RQDRating             float64 `json:"RQDRating"`
+	SpacingRating         float64 `json:"spacingRating"`
+	ConditionRating       float64 `json:"conditionRating"`
+	GroundwaterRating     float64 `json:"groundwaterRating"`
+	OrientationAdjustment float64 `json:"orientationAdjustment"`
+	BasicRMR              float64 `json:"basicRMR"` // RMR without orientation adjustment
+	RMR                   float64 `json:"RMR"`
+	Class                 string  `json:"class"`
+	Description           string  `json:"description"`
+	GSI                   float64 `json:"GSI"`
+}
+
+type QInput struct {
+	RQD float64 `json:"RQD"` // %
+	Jn  float64 `json:"Jn"`  // joint set number
+	Jr  float64 `json:"Jr"`  // joint roughness number
+	Ja  float64 `json:"Ja"`  // joint alteration number
+	Jw  float64 `json:"Jw"`  // joint water reduction factor
+	SRF float64 `json:"SRF"` // stress reduction factor
+}
+
+type QResult struct {
+	Q            float64 `json:"Q"`
+	BlockSize    float64 `json:"blockSize"`    // RQD/Jn
+	ShearStress  float64 `json:"shearStress"`  // Jr/Ja
+	ActiveStress float64 `json:"activeStress"` // Jw/SRF
+	Class        string  `json:"class"`
+	RMR          float64 `json:"RMR"`
+	GSI          float64 `json:"GSI"`
+}
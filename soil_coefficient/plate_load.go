@@ -0,0 +1,114 @@
+package soilcoefficient
+
+import (
+	"math"
+
+	pkg "github.com/geoport/GeoGo/internal"
+	"github.com/geoport/GeoGo/models"
+)
+
+// settlement (in cm) at which the modulus of subgrade reaction of the plate is read
+const referenceSettlement = 0.125
+
+// settlement at failure relative to the plate width
+const failureSettlementRatio = 0.1
+
+// safety factor applied to the ultimate bearing capacity
+const safetyFactor = 3.
+
+// interpPressure returns the pressure of the plate load test at the given settlement and whether the settlement is
+// reached in the test. The last pressure of the test is returned if the settlement is not reached.
+func interpPressure(test models.PlateLoadTest, settlement float64) (float64, bool) {
+	readings := test.Readings
+	if len(readings) == 0 {
+		return 0, false
+	}
+	for i := 1; i < len(readings); i++ {
+		prev := readings[i-1]
+		curr := readings[i]
+		if curr.Settlement >= settlement && curr.Settlement > prev.Settlement {
+			return pkg.Interp(
+				settlement, []float64{prev.Settlement, curr.Settlement}, []float64{prev.Pressure, curr.Pressure},
+			), true
+		}
+	}
+	return readings[len(readings)-1].Pressure, false
+}
+
+// calcSizeFactor returns Terzaghi's (1955) factor that scales the modulus of subgrade reaction of a plate of width b
+// to a foundation of width B.
+func calcSizeFactor(isCohesive bool, b, B float64) float64 {
+	if isCohesive {
+		return b / B
+	}
+	return math.Pow((B+b)/(2*B), 2)
+}
+
+// calcShapeFactor returns Terzaghi's (1955) factor of a rectangular foundation on clay.
+func calcShapeFactor(isCohesive bool, B, L float64) float64 {
+	if !isCohesive || L <= B {
+		return 1
+	}
+	m := L / B
+	return (m + 0.5) / (1.5 * m)
+}
+
+// InterpretPlateLoadTest calculates the modulus of subgrade reaction and the bearing capacity of a foundation from a
+// plate load test.
+//
+// The modulus of the plate is the secant modulus at 1.25 mm settlement, or at the last reading if the test stops
+// before, and it is scaled to the foundation size and shape by Terzaghi (1955). The ultimate pressure of the plate is
+// read at a settlement of 10% of the plate width, or taken as the maximum pressure if the test stops before. It is
+// scaled with B/b in granular soils (Terzaghi & Peck) and taken as is in cohesive soils.
+//
+// Parameters:
+//
+// - soilProfile (models.SoilProfile): The soil profile. The layer at the foundation depth selects sand or clay.
+//
+// - foundationData (models.Foundation): The foundation data.
+//
+// - test (models.PlateLoadTest): The plate load test.
+//
+// Returns:
+//
+// - result (PlateLoadResult): A zero result is returned if the test has no readings.
+func InterpretPlateLoadTest(
+	soilProfile models.SoilProfile, foundationData models.Foundation, test models.PlateLoadTest,
+) PlateLoadResult {
+	if len(test.Readings) == 0 {
+		return PlateLoadResult{}
+	}
+	B := foundationData.FoundationWidth
+	L := math.Max(foundationData.FoundationLength, B)
+	b := test.PlateWidth
+	isCohesive := soilProfile.Layers[soilProfile.GetLayerIndex(foundationData.FoundationDepth)].IsCohesive
+
+	settlement := referenceSettlement
+	pressure, isReferenceReached := interpPressure(test, referenceSettlement)
+	if !isReferenceReached {
+		settlement = test.Readings[len(test.Readings)-1].Settlement
+	}
+	plateCoefficient := CalcSoilCoefficientBySettlement(settlement, pressure)
+	sizeFactor := calcSizeFactor(isCohesive, b, B)
+	shapeFactor := calcShapeFactor(isCohesive, B, L)
+
+	plateUltimate, isFailureReached := interpPressure(test, failureSettlementRatio*b*100)
+	ultimate := plateUltimate
+	if !isCohesive {
+		ultimate *= B / b
+	}
+
+	return PlateLoadResult{
+		ReferenceSettlement:      settlement,
+		IsReferenceReached:       isReferenceReached,
+		PlateCoefficient:         plateCoefficient,
+		SizeFactor:               sizeFactor,
+		ShapeFactor:              shapeFactor,
+		SoilCoefficient:          plateCoefficient * sizeFactor * shapeFactor,
+		PlateUltimatePressure:    plateUltimate,
+		IsFailureReached:         isFailureReached,
+		UltimateBearingCapacity:  ultimate,
+		SafetyFactor:             safetyFactor,
+		AllowableBearingCapacity: ultimate / safetyFactor,
+	}
+}
@@ -0,0 +1,67 @@
+package soilcoefficient
+
+import (
+	"testing"
+
+	dt "github.com/geoport/GeoGo/data"
+	pkg "github.com/geoport/GeoGo/internal"
+)
+
+func TestInterpretPlateLoadTest(t *testing.T) {
+	soilProfile := dt.SoilProfile.Copy()
+	soilProfile.CalcLayerDepths()
+
+	output := InterpretPlateLoadTest(soilProfile, dt.FoundationData, dt.PlateLoadTest)
+
+	values := []float64{output.PlateCoefficient, output.SoilCoefficient, output.UltimateBearingCapacity, output.AllowableBearingCapacity}
+	expected := []float64{5800, 145, 33.97, 11.32}
+	if !pkg.AssertFloatArray(values, expected, 0.01) {
+		t.Errorf("Got %v, want %v", values, expected)
+	}
+	if !output.IsFailureReached || !output.IsReferenceReached {
+		t.Errorf("Got %v and %v, want %v and %v for failure and reference settlement", output.IsFailureReached, output.IsReferenceReached, true, true)
+	}
+
+	soilProfile.Layers[0].IsCohesive = false
+	output = InterpretPlateLoadTest(soilProfile, dt.FoundationData, dt.PlateLoadTest)
+	if !pkg.AssertFloat(output.SizeFactor, 0.2652, 0.0001) || output.ShapeFactor != 1 {
+		t.Errorf("Got %v and %v, want %v and %v for sand", output.SizeFactor, output.ShapeFactor, 0.2652, 1)
+	}
+
+	// a test that stops before 1.25 mm is read at its last settlement
+	test := dt.PlateLoadTest
+	test.Readings = test.Readings[:2]
+	output = InterpretPlateLoadTest(soilProfile, dt.FoundationData, test)
+	if output.IsReferenceReached || output.ReferenceSettlement != 0.08 || !pkg.AssertFloat(output.PlateCoefficient, 6250, 0.01) {
+		t.Errorf("Got %v (%v at %v cm), want %v (%v at %v cm) for short test", output.PlateCoefficient, output.IsReferenceReached, output.ReferenceSettlement, 6250, false, 0.08)
+	}
+
+	test.Readings = nil
+	if output = InterpretPlateLoadTest(soilProfile, dt.FoundationData, test); output != (PlateLoadResult{}) {
+		t.Errorf("Got %v, want zero result for a test without readings", output)
+	}
+}
+
+func TestSubgradeModulus(t *testing.T) {
+	soilProfile := dt.SoilProfile.Copy()
+	soilProfile.CalcLayerDepths()
+
+	vesic := CalcSoilCoefficientByVesic(soilProfile, dt.FoundationData, 0)
+	if !pkg.AssertFloat(vesic.SoilCoefficient, 178.57, 0.01) || len(vesic.Assumptions) != 3 {
+		t.Errorf("Got %v (%v assumptions), want %v (%v assumptions) for Vesic", vesic.SoilCoefficient, len(vesic.Assumptions), 178.57, 3)
+	}
+	vesic = CalcSoilCoefficientByVesic(soilProfile, dt.FoundationData, 500000)
+	if !pkg.AssertFloat(vesic.SoilCoefficient, 154.11, 0.01) {
+		t.Errorf("Got %v, want %v for Vesic with foundation rigidity", vesic.SoilCoefficient, 154.11)
+	}
+
+	bowles := CalcSoilCoefficientByBowles(20, 3)
+	if !pkg.AssertFloat(bowles.SoilCoefficient, 2400, 0.01) {
+		t.Errorf("Got %v, want %v for Bowles", bowles.SoilCoefficient, 2400)
+	}
+
+	terzaghi := CalcSoilCoefficientByTerzaghi(5800, soilProfile, dt.FoundationData)
+	if !pkg.AssertFloat(terzaghi.SoilCoefficient, 145, 0.01) {
+		t.Errorf("Got %v, want %v for Terzaghi", terzaghi.SoilCoefficient, 145)
+	}
+}
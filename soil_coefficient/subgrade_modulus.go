@@ -0,0 +1,110 @@
+package soilcoefficient
+
+import (
+	"math"
+
+	"github.com/geoport/GeoGo/models"
+)
+
+// width (in meters) of the standard plate of Terzaghi's correlations
+const standardPlateWidth = 0.3
+
+// Bowles' factor (in 1/m) between the ultimate bearing capacity and the modulus, the inverse of a 25 mm settlement
+const bowlesFactor = 40.
+
+// CalcSoilCoefficientByVesic calculates the modulus of subgrade reaction by Vesic (1961)
+// ks = 0.65·(Es·B^4/EI)^(1/12)·Es/(B·(1 - ν^2)).
+//
+// Parameters:
+//
+// - soilProfile (models.SoilProfile): The soil profile. The elastic modulus and Poisson's ratio of the layer at the
+// foundation depth are used.
+//
+// - foundationData (models.Foundation): The foundation data.
+//
+// - foundationEI (float64): Flexural rigidity of the foundation (in t.m2). If it is not given, the
+// simplified form ks = Es/(B·(1 - ν^2)) is used.
+//
+// Returns:
+//
+// - result (SubgradeModulus)
+func CalcSoilCoefficientByVesic(
+	soilProfile models.SoilProfile, foundationData models.Foundation, foundationEI float64,
+) SubgradeModulus {
+	layer := soilProfile.Layers[soilProfile.GetLayerIndex(foundationData.FoundationDepth)]
+	Es := layer.ElasticModulus
+	nu := layer.PoissonsRatio
+	B := foundationData.FoundationWidth
+
+	ks := Es / (B * (1 - nu*nu))
+	assumptions := []string{
+		"Soil is a homogeneous elastic half space with the properties of the layer at the foundation depth",
+		"Foundation is an infinite beam of width B",
+	}
+	if foundationEI > 0 {
+		ks *= 0.65 * math.Pow(Es*math.Pow(B, 4)/foundationEI, 1./12)
+	} else {
+		assumptions = append(assumptions, "Foundation rigidity term 0.65·(Es·B^4/EI)^(1/12) is taken as 1")
+	}
+
+	return SubgradeModulus{Method: "Vesic", SoilCoefficient: ks, Assumptions: assumptions}
+}
+
+// CalcSoilCoefficientByBowles calculates the modulus of subgrade reaction by Bowles (1996) ks = 40·SF·qa.
+//
+// Parameters:
+//
+// - allowableBearingCapacity (float64): Allowable bearing capacity (in t/m2).
+//
+// - safetyFactor (float64): Safety factor of the allowable bearing capacity.
+//
+// Returns:
+//
+// - result (SubgradeModulus)
+func CalcSoilCoefficientByBowles(allowableBearingCapacity, safetyFactor float64) SubgradeModulus {
+	return SubgradeModulus{
+		Method:          "Bowles",
+		SoilCoefficient: bowlesFactor * safetyFactor * allowableBearingCapacity,
+		Assumptions: []string{
+			"Ultimate bearing capacity is mobilized at a settlement of 25 mm",
+			"Settlement is proportional to the pressure up to the ultimate bearing capacity",
+		},
+	}
+}
+
+// CalcSoilCoefficientByTerzaghi scales the modulus of subgrade reaction of a 0.3 m square plate to the foundation
+// size and shape by Terzaghi (1955).
+//
+// Parameters:
+//
+// - plateCoefficient (float64): Modulus of subgrade reaction of the 0.3 m plate (in t/m3).
+//
+// - soilProfile (models.SoilProfile): The soil profile. The layer at the foundation depth selects sand or clay.
+//
+// - foundationData (models.Foundation): The foundation data.
+//
+// Returns:
+//
+// - result (SubgradeModulus)
+func CalcSoilCoefficientByTerzaghi(
+	plateCoefficient float64, soilProfile models.SoilProfile, foundationData models.Foundation,
+) SubgradeModulus {
+	B := foundationData.FoundationWidth
+	L := math.Max(foundationData.FoundationLength, B)
+	isCohesive := soilProfile.Layers[soilProfile.GetLayerIndex(foundationData.FoundationDepth)].IsCohesive
+
+	ks := plateCoefficient * calcSizeFactor(isCohesive, standardPlateWidth, B) * calcShapeFactor(isCohesive, B, L)
+
+	assumption := "Granular soil: ks = k1·((B + 0.3)/2B)^2"
+	if isCohesive {
+		assumption = "Cohesive soil: ks = k1·(0.3/B)·(m + 0.5)/1.5m with m = L/B"
+	}
+	return SubgradeModulus{
+		Method:          "Terzaghi",
+		SoilCoefficient: ks,
+		Assumptions: []string{
+			assumption,
+			"Soil below the foundation is the same as below the plate",
+		},
+	}
+}
@@ -0,0 +1,21 @@
+package soilcoefficient
+
+type PlateLoadResult struct {
+	ReferenceSettlement      float64 `json:"referenceSettlement"` // cm
+	IsReferenceReached       bool    `json:"isReferenceReached"`
+	PlateCoefficient         float64 `json:"plateCoefficient"` // t/m3
+	SizeFactor               float64 `json:"sizeFactor"`
+	ShapeFactor              float64 `json:"shapeFactor"`
+	SoilCoefficient          float64 `json:"soilCoefficient"`       // t/m3, for the foundation
+	PlateUltimatePressure    float64 `json:"plateUltimatePressure"` // t/m2
+	IsFailureReached         bool    `json:"isFailureReached"`
+	UltimateBearingCapacity  float64 `json:"ultimateBearingCapacity"` // t/m2
+	SafetyFactor             float64 `json:"safetyFactor"`
+	AllowableBearingCapacity float64 `json:"allowableBearingCapacity"` // t/m2
+}
+
+type SubgradeModulus struct {
+	Method          string   `json:"method"`
+	SoilCoefficient float64  `json:"soilCoefficient"` // t/m3
+	Assumptions     []string `json:"assumptions"`
+}
@@ -0,0 +1,198 @@
+package winkler
+
+import (
+	"math"
+	"sort"
+
+	pkg "github.com/geoport/GeoGo/internal"
+)
+
+// default number of beam elements
+const defaultElements = 100
+
+// maximum number of lift-off iterations
+const maxLiftOffIterations = 50
+
+// createBeamNodes returns the node positions of the beam mesh. The load positions are added to the uniform mesh so
+// that every load acts on a node.
+func createBeamNodes(input BeamInput) []float64 {
+	elements := input.Elements
+	if elements <= 0 {
+		elements = defaultElements
+	}
+
+	var nodes []float64
+	for i := 0; i <= elements; i++ {
+		nodes = append(nodes, input.Length*float64(i)/float64(elements))
+	}
+	for _, load := range input.Loads {
+		nodes = append(nodes, math.Min(math.Max(load.Position, 0), input.Length))
+	}
+	sort.Float64s(nodes)
+
+	unique := []float64{nodes[0]}
+	for _, x := range nodes[1:] {
+		if x-unique[len(unique)-1] > 1e-9 {
+			unique = append(unique, x)
+		}
+	}
+	return unique
+}
+
+// calcTributaryLengths returns the length of the beam represented by each node.
+func calcTributaryLengths(nodes []float64) []float64 {
+	lengths := make([]float64, len(nodes))
+	for i := 0; i < len(nodes)-1; i++ {
+		half := (nodes[i+1] - nodes[i]) / 2
+		lengths[i] += half
+		lengths[i+1] += half
+	}
+	return lengths
+}
+
+// calcElementStiffness returns the stiffness matrix of the Euler-Bernoulli beam element.
+func calcElementStiffness(EI, L float64) [4][4]float64 {
+	c := EI / math.Pow(L, 3)
+	return [4][4]float64{
+		{12 * c, 6 * L * c, -12 * c, 6 * L * c},
+		{6 * L * c, 4 * L * L * c, -6 * L * c, 2 * L * L * c},
+		{-12 * c, -6 * L * c, 12 * c, -6 * L * c},
+		{6 * L * c, 2 * L * L * c, -6 * L * c, 4 * L * L * c},
+	}
+}
+
+// solveBeamOnSprings returns the nodal deflections and rotations of the beam on the given nodal springs.
+func solveBeamOnSprings(nodes []float64, EI float64, springs, forces, moments []float64) ([]float64, []float64) {
+	size := 2 * len(nodes)
+	K := make([][]float64, size)
+	for i := range K {
+		K[i] = make([]float64, size)
+	}
+	F := make([]float64, size)
+
+	for e := 0; e < len(nodes)-1; e++ {
+		k := calcElementStiffness(EI, nodes[e+1]-nodes[e])
+		for a := 0; a < 4; a++ {
+			for b := 0; b < 4; b++ {
+				K[2*e+a][2*e+b] += k[a][b]
+			}
+		}
+	}
+	for i := range nodes {
+		K[2*i][2*i] += springs[i]
+		F[2*i] = forces[i]
+		F[2*i+1] = moments[i]
+	}
+
+	u := pkg.SolveLinear(K, F)
+	w := make([]float64, len(nodes))
+	theta := make([]float64, len(nodes))
+	for i := range nodes {
+		w[i] = u[2*i]
+		theta[i] = u[2*i+1]
+	}
+	return w, theta
+}
+
+// calcInternalForces returns the sagging moment -EI·w” and the shear dM/dx at the nodes. The values of a node are
+// taken from the element on its right, except for the last node.
+func calcInternalForces(nodes []float64, EI float64, w, theta []float64) ([]float64, []float64) {
+	n := len(nodes)
+	moments := make([]float64, n)
+	shears := make([]float64, n)
+	for e := 0; e < n-1; e++ {
+		L := nodes[e+1] - nodes[e]
+		w1, t1, w2, t2 := w[e], theta[e], w[e+1], theta[e+1]
+		curvatureStart := (-6*w1 - 4*L*t1 + 6*w2 - 2*L*t2) / (L * L)
+		curvatureEnd := (6*w1 + 2*L*t1 - 6*w2 + 4*L*t2) / (L * L)
+		shear := -EI * (12*w1 + 6*L*t1 - 12*w2 + 6*L*t2) / math.Pow(L, 3)
+
+		moments[e] = -EI * curvatureStart
+		shears[e] = shear
+		if e == n-2 {
+			moments[e+1] = -EI * curvatureEnd
+			shears[e+1] = shear
+		}
+	}
+	return moments, shears
+}
+
+// SolveBeam analyzes a strip or combined footing as a beam on Winkler springs by the finite element method. The
+// springs of the nodes that move upward are removed and the solution is repeated until the contact zone does not
+// change, unless tension is allowed in the springs.
+//
+// Parameters:
+//
+// - input (BeamInput): Geometry, rigidity, modulus of subgrade reaction and the column loads of the footing.
+//
+// Returns:
+//
+// - result (BeamResult)
+func SolveBeam(input BeamInput) BeamResult {
+	nodes := createBeamNodes(input)
+	n := len(nodes)
+	tributary := calcTributaryLengths(nodes)
+
+	forces := make([]float64, n)
+	moments := make([]float64, n)
+	for _, load := range input.Loads {
+		i := sort.SearchFloat64s(nodes, math.Min(math.Max(load.Position, 0), input.Length)-1e-9)
+		forces[i] += load.Force
+		moments[i] += load.Moment
+	}
+
+	inContact := make([]bool, n)
+	for i := range inContact {
+		inContact[i] = true
+	}
+
+	var w, theta []float64
+	result := BeamResult{Positions: nodes}
+	for result.Iterations < maxLiftOffIterations {
+		result.Iterations++
+		springs := make([]float64, n)
+		for i := range springs {
+			if inContact[i] {
+				springs[i] = input.SoilCoefficient * input.Width * tributary[i]
+			}
+		}
+		w, theta = solveBeamOnSprings(nodes, input.EI, springs, forces, moments)
+
+		changed := false
+		for i := range w {
+			if input.AllowTension {
+				break
+			}
+			contact := w[i] > 0
+			if contact != inContact[i] {
+				inContact[i] = contact
+				changed = true
+			}
+		}
+		if !changed {
+			result.IsConverged = true
+			break
+		}
+	}
+
+	result.Deflections = w
+	result.Rotations = theta
+	result.Moments, result.Shears = calcInternalForces(nodes, input.EI, w, theta)
+	result.MinMoment = math.Inf(1)
+	result.MaxMoment = math.Inf(-1)
+	for i := range nodes {
+		pressure := 0.
+		if input.AllowTension || inContact[i] {
+			pressure = input.SoilCoefficient * w[i]
+		} else {
+			result.LiftOffLength += tributary[i]
+		}
+		result.ContactPressures = append(result.ContactPressures, pressure)
+		result.MaxContactPressure = math.Max(result.MaxContactPressure, pressure)
+		result.MaxDeflection = math.Max(result.MaxDeflection, w[i])
+		result.MaxMoment = math.Max(result.MaxMoment, result.Moments[i])
+		result.MinMoment = math.Min(result.MinMoment, result.Moments[i])
+	}
+
+	return result
+}
@@ -0,0 +1,138 @@
+package winkler
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CreateBeamMesh returns the nodal springs of a strip or combined footing along the x axis with the same mesh as
+// SolveBeam.
+func CreateBeamMesh(input BeamInput) SpringMesh {
+	nodes := createBeamNodes(input)
+	tributary := calcTributaryLengths(nodes)
+
+	var mesh SpringMesh
+	for i, x := range nodes {
+		area := input.Width * tributary[i]
+		mesh.Nodes = append(mesh.Nodes, SpringNode{
+			ID:              i + 1,
+			X:               x,
+			SoilCoefficient: input.SoilCoefficient,
+			Area:            area,
+			Stiffness:       input.SoilCoefficient * area,
+		})
+	}
+	return mesh
+}
+
+// CreateMatMesh returns the nodal springs and the area elements of a rectangular mat with the same mesh as SolveMat.
+// The modulus of subgrade reaction of an area is taken at its center.
+func CreateMatMesh(input MatInput) SpringMesh {
+	nx, ny, a, b := getMatGrid(input)
+	nodeID := func(i, j int) int { return j*(nx+1) + i + 1 }
+
+	var mesh SpringMesh
+	for j := 0; j <= ny; j++ {
+		for i := 0; i <= nx; i++ {
+			x, y := float64(i)*a, float64(j)*b
+			ks := getZoneCoefficient(input, x, y)
+			area := calcTributaryArea(i, j, nx, ny, a, b)
+			mesh.Nodes = append(mesh.Nodes, SpringNode{
+				ID:              nodeID(i, j),
+				X:               x,
+				Y:               y,
+				SoilCoefficient: ks,
+				Area:            area,
+				Stiffness:       ks * area,
+			})
+		}
+	}
+	for j := 0; j < ny; j++ {
+		for i := 0; i < nx; i++ {
+			mesh.Areas = append(mesh.Areas, SpringArea{
+				ID:              j*nx + i + 1,
+				Nodes:           [4]int{nodeID(i, j), nodeID(i+1, j), nodeID(i+1, j+1), nodeID(i, j+1)},
+				SoilCoefficient: getZoneCoefficient(input, (float64(i)+0.5)*a, (float64(j)+0.5)*b),
+			})
+		}
+	}
+	return mesh
+}
+
+// ExportSAP2000 returns the springs of the mesh as SAP2000/ETABS .s2k text tables in Tonf and m units. Area springs
+// are written for the area elements if useAreaSprings is true and the mesh has areas, otherwise uncoupled joint
+// springs are written. Area springs act in compression only, while the joint springs are linear and also carry
+// tension; use area springs or ExportOpenSees with compressionOnly for lift-off.
+func ExportSAP2000(mesh SpringMesh, useAreaSprings bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("TABLE:  \"PROGRAM CONTROL\"\n")
+	sb.WriteString("   ProgramName=SAP2000   CurrUnits=\"Tonf, m, C\"\n\n")
+
+	sb.WriteString("TABLE:  \"JOINT COORDINATES\"\n")
+	for _, node := range mesh.Nodes {
+		sb.WriteString(fmt.Sprintf(
+			"   Joint=%d   CoordSys=GLOBAL   CoordType=Cartesian   XorR=%g   Y=%g   Z=%g\n",
+			node.ID, node.X, node.Y, node.Z,
+		))
+	}
+
+	if useAreaSprings && len(mesh.Areas) > 0 {
+		sb.WriteString("\nTABLE:  \"CONNECTIVITY - AREA\"\n")
+		for _, area := range mesh.Areas {
+			sb.WriteString(fmt.Sprintf(
+				"   Area=%d   NumJoints=4   Joint1=%d   Joint2=%d   Joint3=%d   Joint4=%d\n",
+				area.ID, area.Nodes[0], area.Nodes[1], area.Nodes[2], area.Nodes[3],
+			))
+		}
+
+		sb.WriteString("\nTABLE:  \"AREA SPRING ASSIGNMENTS\"\n")
+		for _, area := range mesh.Areas {
+			sb.WriteString(fmt.Sprintf(
+				"   Area=%d   Type=Simple   Stiffness=%g   SimpleType=\"Compression Only\"   Face=Bottom   Dir=Normal\n",
+				area.ID, area.SoilCoefficient,
+			))
+		}
+		return sb.String()
+	}
+
+	sb.WriteString("\nTABLE:  \"JOINT SPRING ASSIGNMENTS 1 - UNCOUPLED\"\n")
+	for _, node := range mesh.Nodes {
+		sb.WriteString(fmt.Sprintf(
+			"   Joint=%d   CoordSys=GLOBAL   U1=0   U2=0   U3=%g   R1=0   R2=0   R3=0\n",
+			node.ID, node.Stiffness,
+		))
+	}
+	return sb.String()
+}
+
+// ExportOpenSees returns the nodal springs of the mesh as OpenSees Tcl commands in a 3D model with 6 degrees of
+// freedom. Each spring is a zeroLength element in the vertical direction between the node and a fixed node with the id
+// increased by the largest node id of the mesh. The springs use the ENT (elastic no tension) material if
+// compressionOnly is true, and the Elastic material otherwise.
+func ExportOpenSees(mesh SpringMesh, compressionOnly bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("# units: tonf, m\n")
+	sb.WriteString("model BasicBuilder -ndm 3 -ndf 6\n\n")
+
+	material := "Elastic"
+	if compressionOnly {
+		material = "ENT"
+	}
+
+	var offset int
+	for _, node := range mesh.Nodes {
+		offset = max(offset, node.ID)
+	}
+
+	for _, node := range mesh.Nodes {
+		fixedID := node.ID + offset
+		sb.WriteString(fmt.Sprintf("node %d %g %g %g\n", node.ID, node.X, node.Y, node.Z))
+		sb.WriteString(fmt.Sprintf("node %d %g %g %g\n", fixedID, node.X, node.Y, node.Z))
+		sb.WriteString(fmt.Sprintf("fix %d 1 1 1 1 1 1\n", fixedID))
+		sb.WriteString(fmt.Sprintf("uniaxialMaterial %s %d %g\n", material, node.ID, node.Stiffness))
+		sb.WriteString(fmt.Sprintf("element zeroLength %d %d %d -mat %d -dir 3\n", node.ID, fixedID, node.ID, node.ID))
+	}
+	return sb.String()
+}
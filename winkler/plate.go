@@ -0,0 +1,246 @@
+package winkler
+
+import (
+	"math"
+
+	pkg "github.com/geoport/GeoGo/internal"
+)
+
+// default number of plate elements in each direction
+const defaultPlateElements = 20
+
+// shear correction factor of the Mindlin plate
+const shearCorrection = 5. / 6
+
+// natural coordinates of the element nodes in counterclockwise order
+var (
+	nodeXi  = [4]float64{-1, 1, 1, -1}
+	nodeEta = [4]float64{-1, -1, 1, 1}
+)
+
+// calcShapeFunctions returns the bilinear shape functions and their derivatives in x and y of a rectangular
+// element of size a x b at the natural coordinates.
+func calcShapeFunctions(a, b, xi, eta float64) ([4]float64, [4]float64, [4]float64) {
+	var N, dNx, dNy [4]float64
+	for i := 0; i < 4; i++ {
+		N[i] = (1 + xi*nodeXi[i]) * (1 + eta*nodeEta[i]) / 4
+		dNx[i] = nodeXi[i] * (1 + eta*nodeEta[i]) / 4 * 2 / a
+		dNy[i] = nodeEta[i] * (1 + xi*nodeXi[i]) / 4 * 2 / b
+	}
+	return N, dNx, dNy
+}
+
+// calcBendingMatrix returns the curvature-displacement matrix of the element. The degrees of freedom of each node
+// are the deflection w and the rotations θx and θy, which are the slopes ∂w/∂x and ∂w/∂y in the thin plate limit.
+func calcBendingMatrix(dNx, dNy [4]float64) [3][12]float64 {
+	var B [3][12]float64
+	for i := 0; i < 4; i++ {
+		B[0][3*i+1] = dNx[i]
+		B[1][3*i+2] = dNy[i]
+		B[2][3*i+1] = dNy[i]
+		B[2][3*i+2] = dNx[i]
+	}
+	return B
+}
+
+// calcShearMatrix returns the shear strain-displacement matrix of the element.
+func calcShearMatrix(N, dNx, dNy [4]float64) [2][12]float64 {
+	var B [2][12]float64
+	for i := 0; i < 4; i++ {
+		B[0][3*i] = dNx[i]
+		B[0][3*i+1] = -N[i]
+		B[1][3*i] = dNy[i]
+		B[1][3*i+2] = -N[i]
+	}
+	return B
+}
+
+// calcAssumedShearMatrix returns the assumed shear strain-displacement matrix of the MITC4 element (Bathe & Dvorkin,
+// 1985) at the natural coordinates. γxz is interpolated linearly in η from the midpoints of the edges η = ±1 and γyz
+// linearly in ξ from the midpoints of the edges ξ = ±1, which avoids both shear locking and spurious zero energy modes.
+func calcAssumedShearMatrix(a, b, xi, eta float64) [2][12]float64 {
+	tying := func(xi, eta float64) [2][12]float64 {
+		N, dNx, dNy := calcShapeFunctions(a, b, xi, eta)
+		return calcShearMatrix(N, dNx, dNy)
+	}
+	top, bottom := tying(0, 1), tying(0, -1)
+	right, left := tying(1, 0), tying(-1, 0)
+
+	var B [2][12]float64
+	for p := 0; p < 12; p++ {
+		B[0][p] = (1+eta)/2*top[0][p] + (1-eta)/2*bottom[0][p]
+		B[1][p] = (1+xi)/2*right[1][p] + (1-xi)/2*left[1][p]
+	}
+	return B
+}
+
+// calcFlexuralRigidity returns the bending constitutive matrix of the plate.
+func calcFlexuralRigidity(E, nu, t float64) [3][3]float64 {
+	D := E * math.Pow(t, 3) / (12 * (1 - nu*nu))
+	return [3][3]float64{{D, nu * D, 0}, {nu * D, D, 0}, {0, 0, D * (1 - nu) / 2}}
+}
+
+// calcPlateElementStiffness returns the stiffness matrix of the 4 node MITC4 Mindlin plate element. Bending and the
+// assumed shear strains are integrated with 2x2 Gauss points.
+func calcPlateElementStiffness(input MatInput, a, b float64) [12][12]float64 {
+	var K [12][12]float64
+	Db := calcFlexuralRigidity(input.ElasticModulus, input.PoissonsRatio, input.Thickness)
+	G := input.ElasticModulus / (2 * (1 + input.PoissonsRatio))
+	Ds := shearCorrection * G * input.Thickness
+	detJ := a * b / 4
+
+	g := 1 / math.Sqrt(3)
+	for _, xi := range []float64{-g, g} {
+		for _, eta := range []float64{-g, g} {
+			_, dNx, dNy := calcShapeFunctions(a, b, xi, eta)
+			Bb := calcBendingMatrix(dNx, dNy)
+			Bs := calcAssumedShearMatrix(a, b, xi, eta)
+			for p := 0; p < 12; p++ {
+				for q := 0; q < 12; q++ {
+					var sum float64
+					for r := 0; r < 3; r++ {
+						for s := 0; s < 3; s++ {
+							sum += Bb[r][p] * Db[r][s] * Bb[s][q]
+						}
+					}
+					sum += Ds * (Bs[0][p]*Bs[0][q] + Bs[1][p]*Bs[1][q])
+					K[p][q] += sum * detJ
+				}
+			}
+		}
+	}
+	return K
+}
+
+// getZoneCoefficient returns the modulus of subgrade reaction at the point. The last zone containing the point is
+// used, or the default value if there is none.
+func getZoneCoefficient(input MatInput, x, y float64) float64 {
+	ks := input.SoilCoefficient
+	for _, zone := range input.Zones {
+		if x >= zone.XMin-1e-9 && x <= zone.XMax+1e-9 && y >= zone.YMin-1e-9 && y <= zone.YMax+1e-9 {
+			ks = zone.SoilCoefficient
+		}
+	}
+	return ks
+}
+
+// getMatGrid returns the number of elements and the element sizes of the mat mesh.
+func getMatGrid(input MatInput) (int, int, float64, float64) {
+	nx, ny := input.ElementsX, input.ElementsY
+	if nx <= 0 {
+		nx = defaultPlateElements
+	}
+	if ny <= 0 {
+		ny = defaultPlateElements
+	}
+	return nx, ny, input.Length / float64(nx), input.Width / float64(ny)
+}
+
+// calcTributaryArea returns the area of the mat represented by the node (i, j).
+func calcTributaryArea(i, j, nx, ny int, a, b float64) float64 {
+	area := a * b
+	if i == 0 || i == nx {
+		area /= 2
+	}
+	if j == 0 || j == ny {
+		area /= 2
+	}
+	return area
+}
+
+// SolveMat analyzes a rectangular mat foundation as a Mindlin plate on Winkler springs by the finite element method.
+// The springs are lumped to the nodes with their tributary areas and the column loads act on the nearest nodes. The
+// moments and the deflection directly below a column load are singular in plate theory and grow slowly with mesh
+// refinement.
+//
+// Parameters:
+//
+// - input (MatInput): Geometry, material, modulus of subgrade reaction zones and column loads of the mat.
+//
+// Returns:
+//
+// - result (MatResult): Deflections and contact pressures at the nodes and moments at the element centers.
+func SolveMat(input MatInput) MatResult {
+	nx, ny, a, b := getMatGrid(input)
+	nodeCount := (nx + 1) * (ny + 1)
+	nodeIndex := func(i, j int) int { return j*(nx+1) + i }
+
+	size := 3 * nodeCount
+	// the farthest degrees of freedom of an element are 3(nx + 2) + 2 apart
+	K := pkg.NewBandMatrix(size, 3*(nx+3)-1)
+	F := make([]float64, size)
+
+	ke := calcPlateElementStiffness(input, a, b)
+	for j := 0; j < ny; j++ {
+		for i := 0; i < nx; i++ {
+			nodes := [4]int{nodeIndex(i, j), nodeIndex(i+1, j), nodeIndex(i+1, j+1), nodeIndex(i, j+1)}
+			for p := 0; p < 12; p++ {
+				for q := 0; q < 12; q++ {
+					K.Add(3*nodes[p/3]+p%3, 3*nodes[q/3]+q%3, ke[p][q])
+				}
+			}
+		}
+	}
+
+	var result MatResult
+	for j := 0; j <= ny; j++ {
+		for i := 0; i <= nx; i++ {
+			x, y := float64(i)*a, float64(j)*b
+			ks := getZoneCoefficient(input, x, y)
+			K.Add(3*nodeIndex(i, j), 3*nodeIndex(i, j), ks*calcTributaryArea(i, j, nx, ny, a, b))
+
+			result.NodeX = append(result.NodeX, x)
+			result.NodeY = append(result.NodeY, y)
+			result.SoilCoefficients = append(result.SoilCoefficients, ks)
+		}
+	}
+
+	for _, load := range input.Loads {
+		i := int(math.Round(math.Min(math.Max(load.X, 0), input.Length) / a))
+		j := int(math.Round(math.Min(math.Max(load.Y, 0), input.Width) / b))
+		F[3*nodeIndex(i, j)] += load.Force
+	}
+
+	u := pkg.SolveBanded(K, F)
+
+	result.MinDeflection = math.Inf(1)
+	for n := 0; n < nodeCount; n++ {
+		w := u[3*n]
+		pressure := result.SoilCoefficients[n] * w
+		result.Deflections = append(result.Deflections, w)
+		result.ContactPressures = append(result.ContactPressures, pressure)
+		result.MaxDeflection = math.Max(result.MaxDeflection, w)
+		result.MinDeflection = math.Min(result.MinDeflection, w)
+		result.MaxContactPressure = math.Max(result.MaxContactPressure, pressure)
+	}
+
+	Db := calcFlexuralRigidity(input.ElasticModulus, input.PoissonsRatio, input.Thickness)
+	_, dNx, dNy := calcShapeFunctions(a, b, 0, 0)
+	B := calcBendingMatrix(dNx, dNy)
+	for j := 0; j < ny; j++ {
+		for i := 0; i < nx; i++ {
+			nodes := [4]int{nodeIndex(i, j), nodeIndex(i+1, j), nodeIndex(i+1, j+1), nodeIndex(i, j+1)}
+			var curvature [3]float64
+			for r := 0; r < 3; r++ {
+				for p := 0; p < 12; p++ {
+					curvature[r] += B[r][p] * u[3*nodes[p/3]+p%3]
+				}
+			}
+			var moments [3]float64
+			for r := 0; r < 3; r++ {
+				for s := 0; s < 3; s++ {
+					moments[r] -= Db[r][s] * curvature[s]
+				}
+			}
+
+			result.ElementX = append(result.ElementX, (float64(i)+0.5)*a)
+			result.ElementY = append(result.ElementY, (float64(j)+0.5)*b)
+			result.Mx = append(result.Mx, moments[0])
+			result.My = append(result.My, moments[1])
+			result.Mxy = append(result.Mxy, moments[2])
+			result.MaxMoment = math.Max(result.MaxMoment, math.Max(math.Abs(moments[0]), math.Abs(moments[1])))
+		}
+	}
+
+	return result
+}
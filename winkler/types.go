@@ -0,0 +1,99 @@
+package winkler
+
+type PointLoad struct {
+	Position float64 `json:"position"` // meter, distance from the left end
+	Force    float64 `json:"force"`    // ton, downward positive
+	Moment   float64 `json:"moment"`   // t.m, clockwise positive
+}
+
+type BeamInput struct {
+	Length          float64     `json:"length"`          // meter
+	Width           float64     `json:"width"`           // meter
+	EI              float64     `json:"EI"`              // t.m2, flexural rigidity
+	SoilCoefficient float64     `json:"soilCoefficient"` // t/m3
+	Loads           []PointLoad `json:"loads"`
+	Elements        int         `json:"elements"`     // number of elements, 100 if not given
+	AllowTension    bool        `json:"allowTension"` // disables the lift-off iteration
+}
+
+type BeamResult struct {
+	Positions          []float64 `json:"positions"`          // meter
+	Deflections        []float64 `json:"deflections"`        // meter, downward positive
+	Rotations          []float64 `json:"rotations"`          // radian, clockwise positive
+	ContactPressures   []float64 `json:"contactPressures"`   // t/m2
+	Shears             []float64 `json:"shears"`             // ton
+	Moments            []float64 `json:"moments"`            // t.m, sagging positive
+	MaxDeflection      float64   `json:"maxDeflection"`      // meter
+	MaxContactPressure float64   `json:"maxContactPressure"` // t/m2
+	MaxMoment          float64   `json:"maxMoment"`          // t.m
+	MinMoment          float64   `json:"minMoment"`          // t.m
+	LiftOffLength      float64   `json:"liftOffLength"`      // meter
+	Iterations         int       `json:"iterations"`
+	IsConverged        bool      `json:"isConverged"`
+}
+
+type ColumnLoad struct {
+	X     float64 `json:"x"`     // meter
+	Y     float64 `json:"y"`     // meter
+	Force float64 `json:"force"` // ton, downward positive
+}
+
+// SpringZone is a rectangular part of the mat with a different modulus of subgrade reaction.
+type SpringZone struct {
+	XMin            float64 `json:"xMin"`            // meter
+	XMax            float64 `json:"xMax"`            // meter
+	YMin            float64 `json:"yMin"`            // meter
+	YMax            float64 `json:"yMax"`            // meter
+	SoilCoefficient float64 `json:"soilCoefficient"` // t/m3
+}
+
+type MatInput struct {
+	Length          float64      `json:"length"`         // meter, along x
+	Width           float64      `json:"width"`          // meter, along y
+	Thickness       float64      `json:"thickness"`      // meter
+	ElasticModulus  float64      `json:"elasticModulus"` // t/m2
+	PoissonsRatio   float64      `json:"poissonsRatio"`
+	SoilCoefficient float64      `json:"soilCoefficient"` // t/m3, outside the zones
+	Zones           []SpringZone `json:"zones"`
+	Loads           []ColumnLoad `json:"loads"`
+	ElementsX       int          `json:"elementsX"` // number of elements along x, 20 if not given
+	ElementsY       int          `json:"elementsY"` // number of elements along y, 20 if not given
+}
+
+type MatResult struct {
+	NodeX              []float64 `json:"nodeX"`              // meter
+	NodeY              []float64 `json:"nodeY"`              // meter
+	Deflections        []float64 `json:"deflections"`        // meter, downward positive
+	SoilCoefficients   []float64 `json:"soilCoefficients"`   // t/m3
+	ContactPressures   []float64 `json:"contactPressures"`   // t/m2
+	ElementX           []float64 `json:"elementX"`           // meter, element centers
+	ElementY           []float64 `json:"elementY"`           // meter, element centers
+	Mx                 []float64 `json:"Mx"`                 // t.m/m, sagging positive
+	My                 []float64 `json:"My"`                 // t.m/m, sagging positive
+	Mxy                []float64 `json:"Mxy"`                // t.m/m
+	MaxDeflection      float64   `json:"maxDeflection"`      // meter
+	MinDeflection      float64   `json:"minDeflection"`      // meter
+	MaxContactPressure float64   `json:"maxContactPressure"` // t/m2
+	MaxMoment          float64   `json:"maxMoment"`          // t.m/m, absolute maximum of Mx and My
+}
+
+type SpringNode struct {
+	ID              int     `json:"id"`
+	X               float64 `json:"x"`               // meter
+	Y               float64 `json:"y"`               // meter
+	Z               float64 `json:"z"`               // meter
+	SoilCoefficient float64 `json:"soilCoefficient"` // t/m3
+	Area            float64 `json:"area"`            // m2, tributary area
+	Stiffness       float64 `json:"stiffness"`       // t/m, vertical nodal spring
+}
+
+type SpringArea struct {
+	ID              int     `json:"id"`
+	Nodes           [4]int  `json:"nodes"`           // node ids in counterclockwise order
+	SoilCoefficient float64 `json:"soilCoefficient"` // t/m3
+}
+
+type SpringMesh struct {
+	Nodes []SpringNode `json:"nodes"`
+	Areas []SpringArea `json:"areas"`
+}
@@ -0,0 +1,213 @@
+package winkler
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	pkg "github.com/geoport/GeoGo/internal"
+)
+
+func TestSolveBeam(t *testing.T) {
+	// long beam under a central point load compared with Hetenyi's closed form solution
+	input := BeamInput{
+		Length:          40,
+		Width:           1,
+		EI:              10000,
+		SoilCoefficient: 1000,
+		Loads:           []PointLoad{{Position: 20, Force: 100}},
+		Elements:        400,
+		AllowTension:    true,
+	}
+	output := SolveBeam(input)
+
+	lambda := math.Pow(1000./(4*10000), 0.25)
+	expectedDeflection := 100 * lambda / (2 * 1000)
+	expectedMoment := 100 / (4 * lambda)
+	if !pkg.AssertFloat(output.MaxDeflection, expectedDeflection, 1e-4) {
+		t.Errorf("Got %v, want %v for deflection", output.MaxDeflection, expectedDeflection)
+	}
+	if !pkg.AssertFloat(output.MaxMoment, expectedMoment, 0.1) {
+		t.Errorf("Got %v, want %v for moment", output.MaxMoment, expectedMoment)
+	}
+	if output.LiftOffLength != 0 || output.Iterations != 1 {
+		t.Errorf("Got %v and %v, want %v and %v for lift-off", output.LiftOffLength, output.Iterations, 0, 1)
+	}
+}
+
+func TestSolveBeamLiftOff(t *testing.T) {
+	input := BeamInput{
+		Length:          6,
+		Width:           1.5,
+		EI:              50000,
+		SoilCoefficient: 2000,
+		Loads:           []PointLoad{{Position: 1, Force: 60}, {Position: 5, Force: 20, Moment: -40}},
+	}
+	output := SolveBeam(input)
+
+	if !output.IsConverged || !pkg.AssertFloat(output.LiftOffLength, 1.59, 1e-6) {
+		t.Errorf("Got %v (%v), want %v (%v) for lift-off length", output.LiftOffLength, output.IsConverged, 1.59, true)
+	}
+
+	var reaction float64
+	tributary := calcTributaryLengths(output.Positions)
+	for i, pressure := range output.ContactPressures {
+		reaction += pressure * input.Width * tributary[i]
+	}
+	if !pkg.AssertFloat(reaction, 80, 1e-6) {
+		t.Errorf("Got %v, want %v for total reaction", reaction, 80)
+	}
+	ends := []float64{output.Moments[0], output.Moments[len(output.Moments)-1]}
+	if !pkg.AssertFloatArray(ends, []float64{0, 0}, 1e-6) {
+		t.Errorf("Got %v, want %v for end moments", ends, []float64{0, 0})
+	}
+	if !pkg.AssertFloat(output.MaxContactPressure, 23.34, 0.01) {
+		t.Errorf("Got %v, want %v for max contact pressure", output.MaxContactPressure, 23.34)
+	}
+}
+
+func TestSolveMat(t *testing.T) {
+	input := MatInput{
+		Length:          12,
+		Width:           8,
+		Thickness:       0.8,
+		ElasticModulus:  3e6,
+		PoissonsRatio:   0.2,
+		SoilCoefficient: 2000,
+		Loads:           []ColumnLoad{{X: 6, Y: 4, Force: 400}},
+		ElementsX:       24,
+		ElementsY:       16,
+	}
+	output := SolveMat(input)
+
+	var reaction float64
+	for n, pressure := range output.ContactPressures {
+		area := 0.25
+		if output.NodeX[n] == 0 || output.NodeX[n] == 12 {
+			area /= 2
+		}
+		if output.NodeY[n] == 0 || output.NodeY[n] == 8 {
+			area /= 2
+		}
+		reaction += pressure * area
+	}
+	if !pkg.AssertFloat(reaction, 400, 1e-6) {
+		t.Errorf("Got %v, want %v for total reaction", reaction, 400)
+	}
+	if !pkg.AssertFloat(output.MaxContactPressure, 8.41, 0.01) {
+		t.Errorf("Got %v, want %v for max contact pressure", output.MaxContactPressure, 8.41)
+	}
+	// away from the load the deflections are converged: 3.110 mm with 24x16, 48x32 and 96x64 elements
+	if !pkg.AssertFloat(output.Deflections[8*25+8], 3.110e-3, 1e-5) {
+		t.Errorf("Got %v, want %v for deflection 2 m from the load", output.Deflections[8*25+8], 3.110e-3)
+	}
+	// without spurious zero energy modes the deflections decrease steadily away from the load
+	for i := 12; i < 24; i++ {
+		if output.Deflections[8*25+i+1] >= output.Deflections[8*25+i] {
+			t.Errorf("Deflection at node %v is not smaller than at node %v", i+1, i)
+		}
+	}
+
+	// softer zone under half of the mat rotates it toward the zone
+	input.Zones = []SpringZone{{XMin: 0, XMax: 6, YMin: 0, YMax: 8, SoilCoefficient: 1000}}
+	output = SolveMat(input)
+	left, right := output.Deflections[0], output.Deflections[24]
+	if output.SoilCoefficients[0] != 1000 || output.SoilCoefficients[24] != 2000 || left <= right {
+		t.Errorf("Got %v and %v, want larger deflection under the softer zone", left, right)
+	}
+}
+
+func TestSolveMatHertz(t *testing.T) {
+	// a large plate under a point load compared with Hertz's solution w = P/(8√(kD)) of an infinite thin plate, the
+	// difference is mostly the shear deformation of the Mindlin plate below the load
+	output := SolveMat(MatInput{
+		Length:          24,
+		Width:           24,
+		Thickness:       0.8,
+		ElasticModulus:  3e6,
+		PoissonsRatio:   0.2,
+		SoilCoefficient: 2000,
+		Loads:           []ColumnLoad{{X: 12, Y: 12, Force: 400}},
+		ElementsX:       24,
+		ElementsY:       24,
+	})
+	D := 3e6 * math.Pow(0.8, 3) / (12 * (1 - 0.2*0.2))
+	expected := 400 / (8 * math.Sqrt(2000*D))
+	if !pkg.AssertFloat(output.MaxDeflection, expected, 0.08*expected) {
+		t.Errorf("Got %v, want %v for deflection", output.MaxDeflection, expected)
+	}
+}
+
+func TestSolveMatStrip(t *testing.T) {
+	// a narrow mat with zero Poisson's ratio behaves as a beam on springs
+	mat := SolveMat(MatInput{
+		Length:          40,
+		Width:           1,
+		Thickness:       0.5,
+		ElasticModulus:  3e6,
+		SoilCoefficient: 1000,
+		Loads:           []ColumnLoad{{X: 20, Y: 0, Force: 25}, {X: 20, Y: 0.5, Force: 50}, {X: 20, Y: 1, Force: 25}},
+		ElementsX:       100,
+		ElementsY:       2,
+	})
+	beam := SolveBeam(BeamInput{
+		Length:          40,
+		Width:           1,
+		EI:              3e6 * 0.125 / 12,
+		SoilCoefficient: 1000,
+		Loads:           []PointLoad{{Position: 20, Force: 100}},
+		AllowTension:    true,
+	})
+
+	if !pkg.AssertFloat(mat.MaxDeflection, beam.MaxDeflection, 0.01*beam.MaxDeflection) {
+		t.Errorf("Got %v, want %v for deflection", mat.MaxDeflection, beam.MaxDeflection)
+	}
+}
+
+func TestExport(t *testing.T) {
+	mat := CreateMatMesh(MatInput{
+		Length:          2,
+		Width:           1,
+		SoilCoefficient: 2000,
+		Zones:           []SpringZone{{XMin: 1, XMax: 2, YMin: 0, YMax: 1, SoilCoefficient: 1000}},
+		ElementsX:       2,
+		ElementsY:       1,
+	})
+	if len(mat.Nodes) != 6 || len(mat.Areas) != 2 {
+		t.Fatalf("Got %v nodes and %v areas, want %v and %v", len(mat.Nodes), len(mat.Areas), 6, 2)
+	}
+	if mat.Nodes[0].Stiffness != 500 || mat.Nodes[1].Stiffness != 500 || mat.Areas[1].SoilCoefficient != 1000 {
+		t.Errorf("Got %v, %v and %v, want %v, %v and %v for springs", mat.Nodes[0].Stiffness, mat.Nodes[1].Stiffness, mat.Areas[1].SoilCoefficient, 500, 500, 1000)
+	}
+
+	sap := ExportSAP2000(mat, true)
+	expectedLines := []string{
+		"   Joint=5   CoordSys=GLOBAL   CoordType=Cartesian   XorR=1   Y=1   Z=0",
+		"   Area=2   NumJoints=4   Joint1=2   Joint2=3   Joint3=6   Joint4=5",
+		"   Area=2   Type=Simple   Stiffness=1000   SimpleType=\"Compression Only\"   Face=Bottom   Dir=Normal",
+	}
+	for _, line := range expectedLines {
+		if !strings.Contains(sap, line+"\n") {
+			t.Errorf("Missing line %q in SAP2000 export", line)
+		}
+	}
+	sap = ExportSAP2000(mat, false)
+	if !strings.Contains(sap, "   Joint=2   CoordSys=GLOBAL   U1=0   U2=0   U3=500   R1=0   R2=0   R3=0\n") {
+		t.Errorf("Missing joint spring in SAP2000 export")
+	}
+
+	beam := CreateBeamMesh(BeamInput{Length: 4, Width: 2, SoilCoefficient: 1000, Elements: 4})
+	tcl := ExportOpenSees(beam, true)
+	expectedLines = []string{
+		"node 3 2 0 0",
+		"fix 8 1 1 1 1 1 1",
+		"uniaxialMaterial ENT 3 2000",
+		"uniaxialMaterial ENT 5 1000",
+		"element zeroLength 3 8 3 -mat 3 -dir 3",
+	}
+	for _, line := range expectedLines {
+		if !strings.Contains(tcl, line+"\n") {
+			t.Errorf("Missing line %q in OpenSees export", line)
+		}
+	}
+}